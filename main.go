@@ -103,31 +103,19 @@ func main() {
 	}
 
 	if dump {
-		ks := []OrderedCSS{}
-		for k, v := range bs {
-			v.Selector = k
-			ks = append(ks, v)
-		}
-		slices.SortFunc(ks, func(a, b OrderedCSS) int {
-			return OrderedCSSLess(a, b)
-		})
-		fmt.Println(OrderedCSSArrToString(ks))
+		fmt.Println(OrderedCSSArrToString(sortedBaseClasses(bs)))
 		os.Exit(0)
 	}
 
 	if *list {
-		ks := []OrderedCSS{}
-		for k, v := range bs {
-			v.Selector = k
-			ks = append(ks, v)
-		}
-		slices.SortFunc(ks, func(a, b OrderedCSS) int {
-			return OrderedCSSLess(a, b)
-		})
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 		fmt.Fprintln(w, "class\torder number\tdeclarations\t")
-		for _, c := range ks {
-			fmt.Fprintf(w, "%s\t%d\t", c.Selector, c.order)
+		for _, c := range sortedBaseClasses(bs) {
+			class := c.Selector
+			for _, m := range c.MediaQueries {
+				class += " @media (" + m + ")"
+			}
+			fmt.Fprintf(w, "%s\t%d\t", class, c.order)
 			for _, d := range c.Declarations {
 				fmt.Fprintf(w, "%s: %s;\t", d.Property, d.Value)
 			}
@@ -230,11 +218,54 @@ func HandleConfigFile(fileName *string) map[string]OrderedCSS {
 		defaultBreakpoints = config.Theme.Screens
 	}
 	if config.Theme.Extend.Screens != nil {
-		maps.Copy(defaultColors, config.Theme.Extend.Screens)
+		maps.Copy(defaultBreakpoints, config.Theme.Extend.Screens)
+	}
+	if config.Theme.Container.Center || config.Theme.Container.Padding != nil {
+		defaultContainer = config.Theme.Container
+	}
+	if config.Theme.Extend.Container.Center {
+		defaultContainer.Center = true
+	}
+	if config.Theme.Extend.Container.Padding != nil {
+		if defaultContainer.Padding == nil {
+			defaultContainer.Padding = ContainerPadding{}
+		}
+		maps.Copy(defaultContainer.Padding, config.Theme.Extend.Container.Padding)
+	}
+	if config.Theme.Spacing != nil {
+		defaultSpacing = config.Theme.Spacing
+	}
+	if config.Theme.Extend.Spacing != nil {
+		maps.Copy(defaultSpacing, config.Theme.Extend.Spacing)
+	}
+	if config.Theme.FontFamily != nil {
+		defaultFontFamily = config.Theme.FontFamily
+	}
+	if config.Theme.Extend.FontFamily != nil {
+		maps.Copy(defaultFontFamily, config.Theme.Extend.FontFamily)
 	}
 	return MakeBaseClasses(&config)
 }
 
+// every rule of every base class in the order they would be written, for -d and -l
+// the extra rules of a class like container come along with it
+func sortedBaseClasses(bs map[string]OrderedCSS) []OrderedCSS {
+	ks := []OrderedCSS{}
+	for k, v := range bs {
+		v.Selector = k
+		for _, r := range v.Rules {
+			r.Selector = k
+			ks = append(ks, OrderedCSS{r, v.order})
+		}
+		v.Rules = nil
+		ks = append(ks, v)
+	}
+	slices.SortFunc(ks, func(a, b OrderedCSS) int {
+		return OrderedCSSLess(a, b)
+	})
+	return ks
+}
+
 //////////////////////////////////////////// FORMAT
 
 func formatWrapper(r io.ByteReader, w io.ByteWriter, vs map[string]Variant, bs map[string]OrderedCSS) {
@@ -310,6 +341,18 @@ type CSS struct {
 	SupportsStatements []string
 	AttributeSelectors []string
 	Declarations       []CSSDeclaration
+	// custom properties the declarations read that need a starting value on every element
+	// they are written once for all of the rules, see variablesBlock
+	Variables []CSSDeclaration
+	// top level rules the declarations need, like the @keyframes for animate-spin
+	// each one is written once, right before the first rule that needs it
+	AtRules []string
+	// goes after the pseudo classes for rules that are really for other elements
+	// space-x-4 styles the children so it is " > :not([hidden]) ~ :not([hidden])"
+	SelectorSuffix string
+	// more rules for the same class that are written right after this one
+	// container has one for every screen, they get the selector and variants of the class when it is used
+	Rules []CSS
 }
 type CSSDeclaration struct {
 	Property string
@@ -334,6 +377,14 @@ func CSSDeepCopy(c CSS) CSS {
 	copy(as, c.AttributeSelectors)
 	decls := make([]CSSDeclaration, len(c.Declarations))
 	copy(decls, c.Declarations)
+	vars := make([]CSSDeclaration, len(c.Variables))
+	copy(vars, c.Variables)
+	ats := make([]string, len(c.AtRules))
+	copy(ats, c.AtRules)
+	rules := make([]CSS, len(c.Rules))
+	for i, r := range c.Rules {
+		rules[i] = CSSDeepCopy(r)
+	}
 
 	return CSS{
 		Selector:           c.Selector,
@@ -346,6 +397,10 @@ func CSSDeepCopy(c CSS) CSS {
 		SupportsStatements: ss,
 		AttributeSelectors: as,
 		Declarations:       decls,
+		Variables:          vars,
+		AtRules:            ats,
+		SelectorSuffix:     c.SelectorSuffix,
+		Rules:              rules,
 	}
 }
 
@@ -375,15 +430,46 @@ func OrderedCSSLess(a, b OrderedCSS) int {
 	if as > bs {
 		return 1
 	}
+	return compareMediaQueries(a.MediaQueries, b.MediaQueries)
+}
+
+// the rules of one class only differ by their media queries, container and its screens
+// less media queries go first and min-widths go from small to big like the breakpoint variants do
+func compareMediaQueries(a, b []string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	for i := range a {
+		aw, aok := strings.CutPrefix(a[i], "min-width: ")
+		bw, bok := strings.CutPrefix(b[i], "min-width: ")
+		if aok && bok && screenWidth(aw) != screenWidth(bw) {
+			if screenWidth(aw) < screenWidth(bw) {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
 	return 0
 }
 
 func (c CSS) String() string {
 	var b strings.Builder
+	// whatever sets the group and peer selectors escapes them since they also have pseudo classes, like groupVariant
 	if len(c.GroupSelector) != 0 {
-		b.WriteString(strings.Replace(c.GroupSelector, "/", "\\/", -1))
+		b.WriteString(c.GroupSelector)
 		b.WriteByte(' ')
 	}
+	if len(c.PeerSelector) != 0 {
+		b.WriteString(c.PeerSelector)
+		b.WriteString(" ~ ")
+	}
+	var as string
+	for _, a := range c.AttributeSelectors {
+		as += "[" + a + "]"
+	}
 	var pc string
 	if len(c.PseudoClasses) >= 1 {
 		pc = ":" + strings.Join(c.PseudoClasses, ":")
@@ -392,15 +478,13 @@ func (c CSS) String() string {
 	if len(c.PseudoElements) >= 1 {
 		pe = "::" + strings.Join(c.PseudoElements, "::")
 	}
-	selector := strings.Replace(c.Selector, "[", "\\[", -1)
-	selector = strings.Replace(selector, "/", "\\/", -1)
-	selector = strings.Replace(selector, "]", "\\]", -1)
-	selector = "." + strings.Replace(selector, ":", "\\:", -1)
+	selector := "." + escapeClassName(c.Selector)
 	cc := ""
 	if c.ChildCombinator != "" {
 		cc = " " + c.ChildCombinator
 	}
-	b.WriteString(selector + cc + pc + pe + " {\n")
+	// the attributes are on the element itself so they go before the marker's " *"
+	b.WriteString(selector + as + cc + pc + c.SelectorSuffix + pe + " {\n")
 	for _, declaration := range c.Declarations {
 
 		b.WriteString(indent + declaration.Property + ": " + declaration.Value + ";\n")
@@ -433,24 +517,59 @@ func OrderedCSSArrToString(c []OrderedCSS) string {
 }
 func OrderedCSSArrToBytes(c []OrderedCSS) []byte {
 	b := bytes.NewBuffer(make([]byte, 0))
-	for _, css := range c {
-		b.WriteString(css.String())
-	}
+	writeOrderedCSSArr(c, b)
 	return b.Bytes()
 }
 
 func WriteOrderedCSSArr(c []OrderedCSS, w *bufio.Writer) {
+	writeOrderedCSSArr(c, w)
+}
+
+func writeOrderedCSSArr(c []OrderedCSS, w io.StringWriter) {
+	w.WriteString(variablesBlock(c))
+	written := map[string]struct{}{}
 	for _, css := range c {
+		for _, at := range css.AtRules {
+			if _, ok := written[at]; ok {
+				continue
+			}
+			written[at] = struct{}{}
+			w.WriteString(at)
+		}
 		w.WriteString(css.String())
 	}
 }
 
+// one rule that gives every custom property used by the rules its starting value
+// this is what lets shadow-md and ring-2 on the same element combine
+func variablesBlock(c []OrderedCSS) string {
+	seen := map[string]struct{}{}
+	var b strings.Builder
+	for _, css := range c {
+		for _, v := range css.Variables {
+			if _, ok := seen[v.Property]; ok {
+				continue
+			}
+			seen[v.Property] = struct{}{}
+			b.WriteString(indent + v.Property + ": " + v.Value + ";\n")
+		}
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	return "*, ::before, ::after {\n" + b.String() + "}\n"
+}
+
 //////////////////////////////////////////// ENGINE
 
 type parsedValue struct {
 	name          string
 	arbitraryText string
 	slashText     string
+	// !p-4, every declaration gets !important
+	important bool
+	// -mt-4, the value is negated, see NegativeValueClass
+	negative bool
 }
 type fullClassInformation struct {
 	variants []parsedValue
@@ -517,6 +636,10 @@ func parsestr(s []byte) *fullClassInformation {
 			return nil
 		}
 		if variant {
+			// only the class can be important or negative, !hover:p-4 means nothing
+			if pv.important || pv.negative {
+				return nil
+			}
 			res.variants = append(res.variants, *pv)
 		} else {
 			res.class = *pv
@@ -527,15 +650,31 @@ func parsestr(s []byte) *fullClassInformation {
 
 // returns the parsed value and if it was a variant or not
 // returns nil if unable to parse, if it returns nil then the bool does not matter
-func parseNextPart(r io.ByteReader) (*parsedValue, bool) {
+func parseNextPart(r io.ByteReader) (pv *parsedValue, variant bool) {
 	// I might be able to flatten this function by storing some state
 	// and updating all the top level if statements to check that state first
 	var name strings.Builder
+	important := false
+	negative := false
+	defer func() {
+		if pv != nil {
+			pv.important = important
+			pv.negative = negative
+		}
+	}()
 	for {
 		b, err := r.ReadByte()
 		if err != nil {
 			return &parsedValue{name: name.String()}, false
 		}
+		if b == '!' && name.Len() == 0 && !important && !negative {
+			important = true
+			continue
+		}
+		if b == '-' && name.Len() == 0 && !negative {
+			negative = true
+			continue
+		}
 		if b == ':' {
 			return &parsedValue{name: name.String()}, true
 		}
@@ -590,22 +729,61 @@ func parseNextPart(r io.ByteReader) (*parsedValue, bool) {
 // [abc] returns "abc"
 // [] returns ""
 // [asdfasdfhalksjdfhaslkjdfhasdkjf (never closed) returns nil
+// [&[open]] returns "&[open]", brackets inside have to be balanced
+// [0_auto] returns "0 auto", see underscoresToSpaces
 // only returns nil if there is a problem with the arbitrary thing
-// TODO: handle edge case of nested []s, probably use some sort of escaping, figure out how tailwind guys do it
 func parseArbitrary(r io.ByteReader) *string {
 	var sb strings.Builder
+	depth := 0
 	for {
 		b, err := r.ReadByte()
 		// TODO: handle this error better, propagate it in the future
 		if err != nil {
 			return nil
 		}
+		if b == '[' {
+			depth++
+		}
 		if b == ']' {
-			s := sb.String()
-			return &s
+			if depth == 0 {
+				s := underscoresToSpaces(sb.String())
+				return &s
+			}
+			depth--
+		}
+		sb.WriteByte(b)
+	}
+}
+
+// class names cannot have spaces so arbitrary values use underscores instead
+// \_ stays an underscore and so does anything inside of url() since paths have underscores all the time
+func underscoresToSpaces(s string) string {
+	var sb strings.Builder
+	depth := 0
+	// the depth of the url( we are in, -1 when not in one
+	urlDepth := -1
+	for i := 0; i < len(s); i++ {
+		b := s[i]
+		switch {
+		case b == '\\' && i+1 < len(s) && s[i+1] == '_':
+			i++
+			b = '_'
+		case b == '(':
+			if urlDepth == -1 && strings.HasSuffix(s[:i], "url") {
+				urlDepth = depth
+			}
+			depth++
+		case b == ')':
+			depth--
+			if depth == urlDepth {
+				urlDepth = -1
+			}
+		case b == '_' && urlDepth == -1:
+			b = ' '
 		}
 		sb.WriteByte(b)
 	}
+	return sb.String()
 }
 
 // only stops at colons
@@ -627,22 +805,27 @@ func parseSlash(r io.ByteReader) (string, bool) {
 }
 
 func createCSSFromClassInformation(c fullClassInformation, selector string, vs map[string]Variant, bs map[string]OrderedCSS) []OrderedCSS {
-	var css OrderedCSS
-	if c.class.arbitraryText == "" {
-		val, ok := bs[c.class.name]
-		if !ok {
-			return nil
+	css, ok := baseClassCSS(c.class, bs)
+	if !ok {
+		return nil
+	}
+	csses := []OrderedCSS{css}
+	// the screens below a breakpoint variant are always overridden by the ones above it, md:container has no sm rule
+	from := breakpointVariantsWidth(c.variants, vs)
+	for _, r := range css.Rules {
+		if mediaQueriesWidth(r.MediaQueries) < from {
+			continue
 		}
-		css = val
-	} else {
-		arb, ok := baseClassesArbitrary[c.class.name]
-		if !ok {
-			return nil
+		csses = append(csses, OrderedCSS{CSSDeepCopy(r), css.order})
+	}
+	rules := len(csses)
+	csses[0].Rules = nil
+	for i := range csses {
+		if c.class.important {
+			csses[i].Declarations = importantDeclarations(csses[i].Declarations)
 		}
-		css = arb.arbitraryValue(c.class.arbitraryText)
+		csses[i].Selector = selector
 	}
-	css.Selector = selector
-	csses := []OrderedCSS{css}
 	slices.Reverse(c.variants)
 	for _, variant := range c.variants {
 		v, ok := vs[variant.name]
@@ -651,6 +834,10 @@ func createCSSFromClassInformation(c fullClassInformation, selector string, vs m
 		}
 		l := len(csses)
 		for j := 0; j < l; j++ {
+			// the rules that are left are on screens at least as wide as the breakpoint, md:container's lg rule is not inside md
+			if _, ok := v.(BreakpointsVariant); ok && j > 0 && j < rules {
+				continue
+			}
 			res := v.convert(variant.arbitraryText, variant.slashText, csses[j].CSS)
 			if res == nil {
 				return nil
@@ -669,6 +856,173 @@ func createCSSFromClassInformation(c fullClassInformation, selector string, vs m
 	return csses
 }
 
+// the widest screen of the breakpoint variants, 0 when there are none
+func breakpointVariantsWidth(variants []parsedValue, vs map[string]Variant) float64 {
+	w := 0.0
+	for _, variant := range variants {
+		if b, ok := vs[variant.name].(BreakpointsVariant); ok {
+			w = max(w, screenWidth(b.value))
+		}
+	}
+	return w
+}
+
+// the widest min-width of the media queries, 0 when there are none
+func mediaQueriesWidth(mqs []string) float64 {
+	w := 0.0
+	for _, q := range mqs {
+		if v, ok := strings.CutPrefix(q, "min-width: "); ok {
+			w = max(w, screenWidth(v))
+		}
+	}
+	return w
+}
+
+// makes a copy since the declarations can belong to the base class map
+func importantDeclarations(ds []CSSDeclaration) []CSSDeclaration {
+	res := make([]CSSDeclaration, len(ds))
+	for i, d := range ds {
+		res[i] = CSSDeclaration{d.Property, d.Value + " !important"}
+	}
+	return res
+}
+
+// looks up the css of the base class, either from the map or by making it from the arbitrary value and slash text
+func baseClassCSS(class parsedValue, bs map[string]OrderedCSS) (OrderedCSS, bool) {
+	if class.negative {
+		return negativeCSS(class)
+	}
+	if class.slashText != "" {
+		if css, ok := modifiedCSS(class); ok {
+			return css, true
+		}
+	}
+	if class.arbitraryText == "" {
+		name := class.name
+		// the parser splits fractions like w-1/2 at the slash
+		if class.slashText != "" {
+			name += "/" + class.slashText
+		}
+		css, ok := bs[name]
+		return css, ok
+	}
+	arb, v, ok := pickArbitraryValueClass(class.name, class.arbitraryText)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	return arb.arbitraryValue(v), true
+}
+
+// picks which of the classes registered for name gets the arbitrary value and returns the value without its type hint
+// the type comes from a hint like the length in text-[length:2rem] or else from inferType
+// the first class that takes the value gets it, if none of them do it goes to the first class registered for the name
+// unless the type came from a hint, then there is no class for it
+func pickArbitraryValueClass(name, v string) (ArbitraryValueClass, string, bool) {
+	arbs := baseClassesArbitrary[name]
+	if len(arbs) == 0 {
+		return nil, "", false
+	}
+	t := ""
+	hint, rest, hinted := cutTypeHint(v)
+	// with no name the value is a whole declaration, [color:red] is not a hint
+	if hinted && name != "" {
+		t, v = hint, rest
+		for _, arb := range arbs {
+			if h, ok := arb.(HintedArbitraryValueClass); ok && h.takesHint(t) {
+				return arb, v, true
+			}
+		}
+	} else {
+		t = inferType(v)
+	}
+	for _, arb := range arbs {
+		if typed, ok := arb.(TypedArbitraryValueClass); ok && typed.takesValue(t, v) {
+			return arb, v, true
+		}
+	}
+	if hinted && name != "" {
+		// a hint is a promise about the value, text-[number:3] is nothing instead of a color
+		// a class that does not say what it takes can only get a hinted value when it has the name to itself, w-[length:3px]
+		if _, typed := arbs[0].(TypedArbitraryValueClass); len(arbs) == 1 && !typed {
+			return arbs[0], v, true
+		}
+		return nil, "", false
+	}
+	return arbs[0], v, true
+}
+
+// text-sm/6 and text-[2rem]/6 go to the ModifierValueClass registered for text
+func modifiedCSS(class parsedValue) (OrderedCSS, bool) {
+	if class.arbitraryText != "" {
+		arb, v, ok := pickArbitraryValueClass(class.name, class.arbitraryText)
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		m, ok := arb.(ModifierValueClass)
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		return m.modifiedValue(v, true, class.slashText)
+	}
+	// text-sm/6 is a font size and text-red-500/50 is a color, each class says no to the values that are not its own
+	arbs, value := findArbitraryValueClasses(class.name)
+	for _, arb := range arbs {
+		m, ok := arb.(ModifierValueClass)
+		if !ok {
+			continue
+		}
+		if css, ok := m.modifiedValue(value, false, class.slashText); ok {
+			return css, true
+		}
+	}
+	return OrderedCSS{}, false
+}
+
+// -mt-4 and -mt-[3px] go to the NegativeValueClass registered for mt
+func negativeCSS(class parsedValue) (OrderedCSS, bool) {
+	if class.arbitraryText != "" {
+		arb, v, ok := pickArbitraryValueClass(class.name, class.arbitraryText)
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		n, ok := arb.(NegativeValueClass)
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		return n.negativeValue(v, true)
+	}
+	name := class.name
+	// the parser splits fractions like -translate-x-1/2 at the slash
+	if class.slashText != "" {
+		name += "/" + class.slashText
+	}
+	arbs, value := findArbitraryValueClasses(name)
+	for _, arb := range arbs {
+		n, ok := arb.(NegativeValueClass)
+		if !ok {
+			continue
+		}
+		if css, ok := n.negativeValue(value, false); ok {
+			return css, true
+		}
+	}
+	return OrderedCSS{}, false
+}
+
+// finds the classes for a name like text-sm by trying text-sm and then text
+// returns the classes and what is left of the name after the base, sm
+func findArbitraryValueClasses(name string) ([]ArbitraryValueClass, string) {
+	for i := len(name) - 1; i > 0; i-- {
+		if name[i] != '-' {
+			continue
+		}
+		if arbs, ok := baseClassesArbitrary[name[:i]]; ok {
+			return arbs, name[i+1:]
+		}
+	}
+	return nil, ""
+}
+
 //////////////////////////////////////////// BASE CLASSES
 
 type BaseClassMap map[string]OrderedCSS
@@ -681,11 +1035,59 @@ type ArbitraryValueClass interface {
 	baseForArbitraryValue() string
 }
 
+// TypedArbitraryValueClass is an ArbitraryValueClass that shares its name with other classes and says which arbitrary values are its own
+// t is the type of the value, from a type hint or inferType
+type TypedArbitraryValueClass interface {
+	takesValue(t, v string) bool
+}
+
+// HintedArbitraryValueClass is an ArbitraryValueClass with types that have to be asked for with a type hint
+// bg-[50%] is a position but bg-[length:50%] is a size, the hinted classes get the value before the typed ones
+type HintedArbitraryValueClass interface {
+	takesHint(t string) bool
+}
+
+// ModifierValueClass is an ArbitraryValueClass that also understands the text after the slash, the 6 in text-sm/6
+// value is either the arbitrary value or the part of the name after the base, returns false if it cannot use them
+type ModifierValueClass interface {
+	modifiedValue(value string, arbitrary bool, modifier string) (OrderedCSS, bool)
+}
+
+// NegativeValueClass is an ArbitraryValueClass that can be negated with a leading -, -mt-4 and -z-[3]
+// value is either the arbitrary value or the part of the name after the base, returns false if it cannot be negative
+type NegativeValueClass interface {
+	negativeValue(value string, arbitrary bool) (OrderedCSS, bool)
+}
+
 type Options struct {
-	Colors     map[string]string `json:"colors"`
-	FontFamily []string          `json:"fontFamily"`
-	Screens    map[string]string `json:"screens"`
-	Spacing    map[string]string `json:"spacing"`
+	Colors     map[string]string   `json:"colors"`
+	Container  ContainerOptions    `json:"container"`
+	FontFamily map[string][]string `json:"fontFamily"`
+	Screens    map[string]string   `json:"screens"`
+	Spacing    map[string]string   `json:"spacing"`
+}
+
+type ContainerOptions struct {
+	Center bool `json:"center"`
+	// DEFAULT is the padding for every screen, the other keys are screens that change it
+	Padding ContainerPadding `json:"padding"`
+}
+
+// the padding can also be one string for every screen, "padding": "2rem"
+type ContainerPadding map[string]string
+
+func (p *ContainerPadding) UnmarshalJSON(bs []byte) error {
+	var s string
+	if json.Unmarshal(bs, &s) == nil {
+		*p = ContainerPadding{"DEFAULT": s}
+		return nil
+	}
+	var m map[string]string
+	if err := json.Unmarshal(bs, &m); err != nil {
+		return err
+	}
+	*p = m
+	return nil
 }
 
 type Theme struct {
@@ -698,10 +1100,36 @@ type Config struct {
 }
 
 func MakeBaseClasses(config *Config) map[string]OrderedCSS {
-	return concatMaps(
+	return concatMaps(baseClassMaps(config)...)
+}
+
+// the maps of every base class, classes that share a name like text-wrap and text-center must not make the same class
+// or one of them is silently replaced, TestNoDuplicateBaseClasses checks for that
+func baseClassMaps(config *Config) []map[string]OrderedCSS {
+	return []map[string]OrderedCSS{
 		// Layout
+		visibility.produceMap(config),
+		position.produceMap(config),
+		baseClassMapFromArrs(config, insets),
+		zIndex.produceMap(config),
+		overflow.produceMap(config),
+		overflowX.produceMap(config),
+		overflowY.produceMap(config),
+		overscroll.produceMap(config),
+		overscrollX.produceMap(config),
+		overscrollY.produceMap(config),
+		objectFit.produceMap(config),
+		objectPosition.produceMap(config),
 		aspectRatio.produceMap(config),
-		// container is unique
+		size.produceMap(config),
+		height.produceMap(config),
+		maxHeight.produceMap(config),
+		minHeight.produceMap(config),
+		width.produceMap(config),
+		minWidth.produceMap(config),
+		maxWidth.produceMap(config),
+		maxWidthScreens.produceMap(config),
+		container.produceMap(config),
 		columns.produceMap(config),
 		breakAfter.produceMap(config),
 		breakBefore.produceMap(config),
@@ -712,48 +1140,436 @@ func MakeBaseClasses(config *Config) map[string]OrderedCSS {
 		floats.produceMap(config),
 		clear.produceMap(config),
 		isolation.produceMap(config),
+		baseClassMapFromArrs(config, margins),
 		// Flexbox & Grid
 		flexBasis.produceMap(config),
 		flexDirection.produceMap(config),
 		flexWrap.produceMap(config),
+		flex.produceMap(config),
+		shrink.produceMap(config),
 		grow.produceMap(config),
+		order.produceMap(config),
+		justifyContent.produceMap(config),
+		justifyItems.produceMap(config),
+		justifySelf.produceMap(config),
+		alignContent.produceMap(config),
+		alignItems.produceMap(config),
+		alignSelf.produceMap(config),
+		placeContent.produceMap(config),
+		placeItems.produceMap(config),
+		placeSelf.produceMap(config),
+		gap.produceMap(config),
+		gapX.produceMap(config),
+		gapY.produceMap(config),
+		baseClassMapFromArrs(config, spaces),
+		baseClassMapFromArrs(config, spaceReverses),
+		baseClassMapFromArrs(config, divides),
+		baseClassMapFromArrs(config, divideReverses),
+		gridTemplateColumns.produceMap(config),
+		gridTemplateRows.produceMap(config),
+		gridColumn.produceMap(config),
+		gridColumnSpan.produceMap(config),
+		gridColumnStart.produceMap(config),
+		gridColumnEnd.produceMap(config),
+		gridRow.produceMap(config),
+		gridRowSpan.produceMap(config),
+		gridRowStart.produceMap(config),
+		gridRowEnd.produceMap(config),
+		gridAutoFlow.produceMap(config),
+		gridAutoColumns.produceMap(config),
+		gridAutoRows.produceMap(config),
+		// Transforms
+		transformOrigin.produceMap(config),
+		baseClassMapFromArrs(config, transforms),
+		transform.produceMap(config),
+		// Filters
+		baseClassMapFromArrs(config, filters),
+		filter.produceMap(config),
+		baseClassMapFromArrs(config, backdropFilters),
+		backdropFilter.produceMap(config),
+		// Transitions & Animation
+		transitionProperty.produceMap(config),
+		transitionDuration.produceMap(config),
+		transitionTimingFunction.produceMap(config),
+		transitionDelay.produceMap(config),
+		animation.produceMap(config),
+		// Spacing
+		baseClassMapFromArrs(config, paddings),
+		// Borders
+		baseClassMapFromArrs(config, borderRadiuses),
+		baseClassMapFromArrs(config, borderWidths),
+		borderStyle.produceMap(config),
+		borderColor.produceMap(config),
 		// Backgrounds
 		backgroundColor.produceMap(config),
+		backgroundImage.produceMap(config),
+		baseClassMapFromArrs(config, gradientColorStops),
+		backgroundSize.produceMap(config),
+		backgroundAttachment.produceMap(config),
+		backgroundClip.produceMap(config),
+		backgroundPosition.produceMap(config),
+		backgroundRepeat.produceMap(config),
+		backgroundOrigin.produceMap(config),
+		// Tables
+		borderCollapse.produceMap(config),
+		baseClassMapFromArrs(config, borderSpacings),
+		tableLayout.produceMap(config),
+		captionSide.produceMap(config),
 		// Typography
+		textAlign.produceMap(config),
+		fontFamily.produceMap(config),
+		fontSize.produceMap(config),
+		fontWeight.produceMap(config),
+		lineHeight.produceMap(config),
+		letterSpacing.produceMap(config),
 		textColor.produceMap(config),
 		textWrap.produceMap(config),
-	)
+		textOverflow.produceMap(config),
+		truncate.produceMap(config),
+		whitespace.produceMap(config),
+		wordBreak.produceMap(config),
+		hyphens.produceMap(config),
+		textIndent.produceMap(config),
+		verticalAlign.produceMap(config),
+		textTransform.produceMap(config),
+		textDecorationLine.produceMap(config),
+		textDecorationColor.produceMap(config),
+		textDecorationStyle.produceMap(config),
+		textDecorationThickness.produceMap(config),
+		textUnderlineOffset.produceMap(config),
+		lineClamp.produceMap(config),
+		content.produceMap(config),
+		listStyleType.produceMap(config),
+		listStylePosition.produceMap(config),
+		listStyleImage.produceMap(config),
+		// Effects
+		opacity.produceMap(config),
+		mixBlendMode.produceMap(config),
+		backgroundBlendMode.produceMap(config),
+		boxShadow.produceMap(config),
+		shadowColor.produceMap(config),
+		// Interactivity
+		cursor.produceMap(config),
+		pointerEvents.produceMap(config),
+		userSelect.produceMap(config),
+		resize.produceMap(config),
+		scrollBehavior.produceMap(config),
+		baseClassMapFromArrs(config, scrollMargins),
+		baseClassMapFromArrs(config, scrollPaddings),
+		scrollSnapType.produceMap(config),
+		scrollSnapAlign.produceMap(config),
+		scrollSnapStop.produceMap(config),
+		touchAction.produceMap(config),
+		willChange.produceMap(config),
+		appearance.produceMap(config),
+		caretColor.produceMap(config),
+		accentColor.produceMap(config),
+		accentAuto.produceMap(config),
+		// SVG
+		fill.produceMap(config),
+		fillNone.produceMap(config),
+		stroke.produceMap(config),
+		strokeNone.produceMap(config),
+		strokeWidth.produceMap(config),
+		// Accessibility
+		srOnly.produceMap(config),
+		notSrOnly.produceMap(config),
+		outlineStyle.produceMap(config),
+		outlineWidth.produceMap(config),
+		outlineOffset.produceMap(config),
+		outlineColor.produceMap(config),
+		ringWidth.produceMap(config),
+		ringColor.produceMap(config),
+		ringOffsetWidth.produceMap(config),
+		ringOffsetColor.produceMap(config),
+	}
+}
+
+// several classes can share a name, the first one registered for a name takes the arbitrary values that none of the others want
+// see pickArbitraryValueClass
+var baseClassesArbitrary = mergeArbitraryMaps(
+	arbitraryMapFromArrs([]ArbitraryValueClass{
+		totallyArbitraryLOL,
+		aspectRatio,
+		zIndex,
+		objectPosition,
+		columns,
+		size,
+		height,
+		maxHeight,
+		minHeight,
+		width,
+		minWidth,
+		maxWidth,
+		flexBasis,
+		grow,
+		shrink,
+		flex,
+		order,
+		gap,
+		gapX,
+		gapY,
+		gridTemplateColumns,
+		gridTemplateRows,
+		gridColumn,
+		gridColumnSpan,
+		gridColumnStart,
+		gridColumnEnd,
+		gridRow,
+		gridRowSpan,
+		gridRowStart,
+		gridRowEnd,
+		gridAutoColumns,
+		gridAutoRows,
+		transformOrigin,
+		transitionProperty,
+		transitionDuration,
+		transitionTimingFunction,
+		transitionDelay,
+		animation,
+		lineHeight,
+		letterSpacing,
+		backgroundColor,
+		backgroundImage,
+		backgroundSize,
+		backgroundPosition,
+		textColor,
+		fontSize,
+		textWrap,
+		fontFamily,
+		fontWeight,
+		borderColor,
+		boxShadow,
+		shadowColor,
+		opacity,
+		ringColor,
+		ringWidth,
+		ringOffsetColor,
+		ringOffsetWidth,
+		outlineColor,
+		outlineWidth,
+		outlineOffset,
+		cursor,
+		willChange,
+		caretColor,
+		accentColor,
+		listStyleType,
+		listStyleImage,
+		textIndent,
+		verticalAlign,
+		textDecorationColor,
+		textDecorationThickness,
+		textUnderlineOffset,
+		lineClamp,
+		content,
+		hyphens,
+		fill,
+		stroke,
+		strokeWidth,
+	}),
+	arbitraryMapFromArrs(spaces),
+	arbitraryMapFromArrs(divides),
+	arbitraryMapFromArrs(borderSpacings),
+	arbitraryMapFromArrs(scrollMargins),
+	arbitraryMapFromArrs(scrollPaddings),
+	arbitraryMapFromArrs(insets),
+	arbitraryMapFromArrs(margins),
+	arbitraryMapFromArrs(transforms),
+	arbitraryMapFromArrs(filters),
+	arbitraryMapFromArrs(backdropFilters),
+	arbitraryMapFromArrs(paddings),
+	arbitraryMapFromArrs(gradientColorStops),
+	arbitraryMapFromArrs(borderRadiuses),
+	arbitraryMapFromArrs(borderWidths),
+)
+
+func baseClassMapFromArrs[T BaseClass](config *Config, arr []T) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for _, b := range arr {
+		maps.Copy(m, b.produceMap(config))
+	}
+	return m
+}
+
+func arbitraryMapFromArrs[T ArbitraryValueClass](arr []T) map[string][]ArbitraryValueClass {
+	m := map[string][]ArbitraryValueClass{}
+	for _, a := range arr {
+		name := a.baseForArbitraryValue()
+		m[name] = append(m[name], a)
+	}
+	return m
 }
 
-var baseClassesArbitrary = map[string]ArbitraryValueClass{
-	totallyArbitraryLOL.baseForArbitraryValue(): totallyArbitraryLOL,
-	aspectRatio.baseForArbitraryValue():         aspectRatio,
-	columns.baseForArbitraryValue():             columns,
-	flexBasis.baseForArbitraryValue():           flexBasis,
-	grow.baseForArbitraryValue():                grow,
-	backgroundColor.baseForArbitraryValue():     backgroundColor,
-	textColor.baseForArbitraryValue():           textColor,
+// like concatMaps but the classes for a name are kept in the order they came in instead of replaced
+func mergeArbitraryMaps(ms ...map[string][]ArbitraryValueClass) map[string][]ArbitraryValueClass {
+	res := map[string][]ArbitraryValueClass{}
+	for _, m := range ms {
+		for k, v := range m {
+			res[k] = append(res[k], v...)
+		}
+	}
+	return res
 }
 
 // ORDERS TODO: reorder this to fit what tailwind does
 const (
 	_ = iota * 100
 
+	containerOrder
+	srOnlyOrder
+	pointerEventsOrder
+	visibilityOrder
+	positionOrder
+	insetOrder
 	isolationOrder
+	zIndexOrder
+	orderOrder
+	gridColumnOrder
+	gridColumnStartOrder
+	gridColumnEndOrder
+	gridRowOrder
+	gridRowStartOrder
+	gridRowEndOrder
 	floatsOrder
 	clearOrder
+	marginOrder
 	boxSizingOrder
+	lineClampOrder
 	displayOrder
 	aspectRatioOrder
+	sizeOrder
+	heightOrder
+	maxHeightOrder
+	minHeightOrder
+	widthOrder
+	minWidthOrder
+	maxWidthOrder
 	columnsOrder
 	breakBeforeOrder
 	breakInsideOrder
 	breakAfterOrder
+	overflowOrder
+	overflowXOrder
+	overflowYOrder
+	overscrollOrder
+	overscrollXOrder
+	overscrollYOrder
+	scrollBehaviorOrder
+	textOverflowOrder
+	hyphensOrder
+	whitespaceOrder
 	textWrapOrder
-
+	wordBreakOrder
+	borderRadiusOrder
+	borderWidthOrder
+	borderStyleOrder
+	borderColorOrder
+	backgroundColorOrder
+	backgroundImageOrder
+	gradientColorStopsOrder
+
+	flexOrder
+	shrinkOrder
 	growOrder
+	flexBasisOrder
+	tableLayoutOrder
+	captionSideOrder
+	borderCollapseOrder
+	borderSpacingOrder
+	transformOriginOrder
+	translateOrder
+	rotateOrder
+	skewOrder
+	scaleOrder
+	transformOrder
+	animationOrder
+	cursorOrder
+	touchActionOrder
+	userSelectOrder
+	resizeOrder
+	scrollSnapTypeOrder
+	scrollSnapAlignOrder
+	scrollSnapStopOrder
+	scrollMarginOrder
+	scrollPaddingOrder
+	listStylePositionOrder
+	listStyleTypeOrder
+	listStyleImageOrder
+	appearanceOrder
+	gridAutoColumnsOrder
+	gridAutoFlowOrder
+	gridAutoRowsOrder
+	gridTemplateColumnsOrder
+	gridTemplateRowsOrder
 	flexDirectionOrder
+	flexWrapOrder
+	placeContentOrder
+	placeItemsOrder
+	alignContentOrder
+	alignItemsOrder
+	justifyContentOrder
+	justifyItemsOrder
+	gapOrder
+	spaceOrder
+	divideWidthOrder
+	divideStyleOrder
+	divideColorOrder
+	placeSelfOrder
+	alignSelfOrder
+	justifySelfOrder
 	boxDecorationOrder
+	backgroundSizeOrder
+	backgroundAttachmentOrder
+	backgroundClipOrder
+	backgroundPositionOrder
+	backgroundRepeatOrder
+	backgroundOriginOrder
+	fillOrder
+	strokeOrder
+	strokeWidthOrder
+	objectFitOrder
+	objectPositionOrder
+	paddingOrder
+	textAlignOrder
+	textIndentOrder
+	verticalAlignOrder
+	fontFamilyOrder
+	fontSizeOrder
+	fontWeightOrder
+	textTransformOrder
+	lineHeightOrder
+	letterSpacingOrder
+	textColorOrder
+	textDecorationLineOrder
+	textDecorationColorOrder
+	textDecorationStyleOrder
+	textDecorationThicknessOrder
+	textUnderlineOffsetOrder
+	caretColorOrder
+	accentColorOrder
+	opacityOrder
+	backgroundBlendModeOrder
+	mixBlendModeOrder
+	boxShadowOrder
+	boxShadowColorOrder
+	outlineStyleOrder
+	outlineWidthOrder
+	outlineOffsetOrder
+	outlineColorOrder
+	ringWidthOrder
+	ringColorOrder
+	ringOffsetWidthOrder
+	ringOffsetColorOrder
+	filterFunctionsOrder
+	filterOrder
+	backdropFilterFunctionsOrder
+	backdropFilterOrder
+	transitionPropertyOrder
+	transitionDelayOrder
+	transitionDurationOrder
+	transitionTimingFunctionOrder
+	willChangeOrder
+	contentOrder
 	// should always be last I think
 	totallyArbitraryLOLOrder
 )
@@ -768,6 +1584,12 @@ type ArbitraryValueKeywordClass struct {
 	name     string
 	property string
 	defaults map[string]string
+	// the arbitrary value types it takes when it shares its name, see TypedArbitraryValueClass
+	types []string
+	// the types it only takes from a type hint, see HintedArbitraryValueClass
+	hints []string
+	// also take -name-* for the defaults that are numbers, see NegativeValueClass
+	negative bool
 	order    int
 }
 
@@ -796,6 +1618,38 @@ func (a ArbitraryValueKeywordClass) baseForArbitraryValue() string {
 	return a.name
 }
 
+func (a ArbitraryValueKeywordClass) negativeValue(value string, arbitrary bool) (OrderedCSS, bool) {
+	if !a.negative {
+		return OrderedCSS{}, false
+	}
+	if !arbitrary {
+		// -order-first is not a thing but -tracking-wide is, the defaults that are lengths can be negative
+		v, ok := a.defaults[value]
+		if !ok || !isNumber(value) && !isLength(v) {
+			return OrderedCSS{}, false
+		}
+		value = v
+	}
+	return a.arbitraryValue(negate(value)), true
+}
+
+func (a ArbitraryValueKeywordClass) takesHint(t string) bool {
+	return slices.Contains(a.hints, t)
+}
+
+// also takes its own keywords, text-[balance] is text-wrap
+func (a ArbitraryValueKeywordClass) takesValue(t, v string) bool {
+	if slices.Contains(a.types, t) {
+		return true
+	}
+	for _, d := range a.defaults {
+		if d == v {
+			return true
+		}
+	}
+	return false
+}
+
 var grow = ArbitraryValueKeywordClass{
 	name:     "grow",
 	property: "flex-grow",
@@ -806,6 +1660,85 @@ var grow = ArbitraryValueKeywordClass{
 	order: growOrder,
 }
 
+var shrink = ArbitraryValueKeywordClass{
+	name:     "shrink",
+	property: "flex-shrink",
+	defaults: map[string]string{
+		"":  "1",
+		"0": "0",
+	},
+	order: shrinkOrder,
+}
+
+var flex = ArbitraryValueKeywordClass{
+	name:     "flex",
+	property: "flex",
+	defaults: map[string]string{
+		"1":       "1 1 0%",
+		"auto":    "1 1 auto",
+		"initial": "0 1 auto",
+		"none":    "none",
+	},
+	order: flexOrder,
+}
+
+var order = ArbitraryValueKeywordClass{
+	name:     "order",
+	property: "order",
+	defaults: map[string]string{
+		"1":     "1",
+		"2":     "2",
+		"3":     "3",
+		"4":     "4",
+		"5":     "5",
+		"6":     "6",
+		"7":     "7",
+		"8":     "8",
+		"9":     "9",
+		"10":    "10",
+		"11":    "11",
+		"12":    "12",
+		"first": "-9999",
+		"last":  "9999",
+		"none":  "0",
+	},
+	negative: true,
+	order:    orderOrder,
+}
+
+var zIndex = ArbitraryValueKeywordClass{
+	name:     "z",
+	property: "z-index",
+	defaults: map[string]string{
+		"0":    "0",
+		"10":   "10",
+		"20":   "20",
+		"30":   "30",
+		"40":   "40",
+		"50":   "50",
+		"auto": "auto",
+	},
+	negative: true,
+	order:    zIndexOrder,
+}
+
+var objectPosition = ArbitraryValueKeywordClass{
+	name:     "object",
+	property: "object-position",
+	defaults: map[string]string{
+		"bottom":       "bottom",
+		"center":       "center",
+		"left":         "left",
+		"left-bottom":  "left bottom",
+		"left-top":     "left top",
+		"right":        "right",
+		"right-bottom": "right bottom",
+		"right-top":    "right top",
+		"top":          "top",
+	},
+	order: objectPositionOrder,
+}
+
 var aspectRatio = ArbitraryValueKeywordClass{
 	name:     "aspect",
 	property: "aspect-ratio",
@@ -823,13 +1756,9 @@ func (L LOLOLOL) arbitraryValue(v string) OrderedCSS {
 	declsStrs := strings.Split(v, ";")
 	decls := []CSSDeclaration{}
 	for _, s := range declsStrs {
-		parts := strings.Split(s, ":")
-		if len(parts) == 2 {
-
-			decls = append(decls, CSSDeclaration{parts[0], parts[1]})
-		} else {
-			decls = append(decls, CSSDeclaration{parts[0], ""})
-		}
+		// only the first colon, [background:url(https://a.png)]
+		property, value, _ := strings.Cut(s, ":")
+		decls = append(decls, CSSDeclaration{property, value})
 	}
 	return OrderedCSS{
 		CSS{Declarations: decls}, totallyArbitraryLOLOrder,
@@ -898,7 +1827,7 @@ var flexWrap = KeywordBaseClass{
 		"wrap-reverse": "wrap-reverse",
 		"nowrap":       "nowrap",
 	},
-	order: 0,
+	order: flexWrapOrder,
 }
 
 var flexDirection = KeywordBaseClass{
@@ -907,68 +1836,264 @@ var flexDirection = KeywordBaseClass{
 	values: map[string]string{
 		"row":         "row",
 		"row-reverse": "row-reverse",
-		"col":         "col",
-		"col-reverse": "col-reverse",
+		"col":         "column",
+		"col-reverse": "column-reverse",
 	},
 	order: flexDirectionOrder,
 }
 
-var breakAfter = KeywordBaseClass{
-	name:     "break-after",
-	property: "break-after",
+var justifyContent = KeywordBaseClass{
+	name:     "justify",
+	property: "justify-content",
 	values: map[string]string{
-		"auto":       "auto",
-		"avoid":      "avoid",
-		"all":        "all",
-		"avoid-page": "avoid-page",
-		"page":       "page",
-		"left":       "left",
-		"right":      "right",
-		"column":     "column",
+		"normal":  "normal",
+		"start":   "flex-start",
+		"end":     "flex-end",
+		"center":  "center",
+		"between": "space-between",
+		"around":  "space-around",
+		"evenly":  "space-evenly",
+		"stretch": "stretch",
 	},
-	order: breakAfterOrder,
+	order: justifyContentOrder,
 }
-var breakBefore = KeywordBaseClass{
-	name:     "break-before",
-	property: "break-before",
+
+var justifyItems = KeywordBaseClass{
+	name:     "justify-items",
+	property: "justify-items",
 	values: map[string]string{
-		"auto":       "auto",
-		"avoid":      "avoid",
-		"all":        "all",
-		"avoid-page": "avoid-page",
-		"page":       "page",
-		"left":       "left",
-		"right":      "right",
-		"column":     "column",
+		"start":   "start",
+		"end":     "end",
+		"center":  "center",
+		"stretch": "stretch",
 	},
-	order: breakBeforeOrder,
+	order: justifyItemsOrder,
 }
-var breakInside = KeywordBaseClass{
-	name:     "break-inside",
-	property: "break-inside",
+
+var justifySelf = KeywordBaseClass{
+	name:     "justify-self",
+	property: "justify-self",
 	values: map[string]string{
-		"auto":         "auto",
-		"avoid":        "avoid",
-		"avoid-page":   "avoid-page",
-		"avoid-column": "avoid-column",
+		"auto":    "auto",
+		"start":   "start",
+		"end":     "end",
+		"center":  "center",
+		"stretch": "stretch",
 	},
-	order: breakInsideOrder,
+	order: justifySelfOrder,
 }
-var boxDecoration = KeywordBaseClass{
-	name:     "box-decoration",
-	property: "box-decoration-break",
+
+var alignContent = KeywordBaseClass{
+	name:     "content",
+	property: "align-content",
 	values: map[string]string{
-		"clone": "clone",
-		"slice": "slice",
+		"normal":   "normal",
+		"center":   "center",
+		"start":    "flex-start",
+		"end":      "flex-end",
+		"between":  "space-between",
+		"around":   "space-around",
+		"evenly":   "space-evenly",
+		"baseline": "baseline",
+		"stretch":  "stretch",
 	},
-	order: boxDecorationOrder,
+	order: alignContentOrder,
 }
-var boxSizing = KeywordBaseClass{
-	name:     "box",
-	property: "box-sizing",
+
+var alignItems = KeywordBaseClass{
+	name:     "items",
+	property: "align-items",
 	values: map[string]string{
-		"border":  "border-box",
-		"content": "content-box",
+		"start":    "flex-start",
+		"end":      "flex-end",
+		"center":   "center",
+		"baseline": "baseline",
+		"stretch":  "stretch",
+	},
+	order: alignItemsOrder,
+}
+
+var alignSelf = KeywordBaseClass{
+	name:     "self",
+	property: "align-self",
+	values: map[string]string{
+		"auto":     "auto",
+		"start":    "flex-start",
+		"end":      "flex-end",
+		"center":   "center",
+		"stretch":  "stretch",
+		"baseline": "baseline",
+	},
+	order: alignSelfOrder,
+}
+
+var placeContent = KeywordBaseClass{
+	name:     "place-content",
+	property: "place-content",
+	values: map[string]string{
+		"center":   "center",
+		"start":    "start",
+		"end":      "end",
+		"between":  "space-between",
+		"around":   "space-around",
+		"evenly":   "space-evenly",
+		"baseline": "baseline",
+		"stretch":  "stretch",
+	},
+	order: placeContentOrder,
+}
+
+var placeItems = KeywordBaseClass{
+	name:     "place-items",
+	property: "place-items",
+	values: map[string]string{
+		"start":    "start",
+		"end":      "end",
+		"center":   "center",
+		"baseline": "baseline",
+		"stretch":  "stretch",
+	},
+	order: placeItemsOrder,
+}
+
+var placeSelf = KeywordBaseClass{
+	name:     "place-self",
+	property: "place-self",
+	values: map[string]string{
+		"auto":    "auto",
+		"start":   "start",
+		"end":     "end",
+		"center":  "center",
+		"stretch": "stretch",
+	},
+	order: placeSelfOrder,
+}
+
+var overflowValues = map[string]string{
+	"auto":    "auto",
+	"hidden":  "hidden",
+	"clip":    "clip",
+	"visible": "visible",
+	"scroll":  "scroll",
+}
+
+var overflow = KeywordBaseClass{
+	name:     "overflow",
+	property: "overflow",
+	values:   overflowValues,
+	order:    overflowOrder,
+}
+
+var overflowX = KeywordBaseClass{
+	name:     "overflow-x",
+	property: "overflow-x",
+	values:   overflowValues,
+	order:    overflowXOrder,
+}
+
+var overflowY = KeywordBaseClass{
+	name:     "overflow-y",
+	property: "overflow-y",
+	values:   overflowValues,
+	order:    overflowYOrder,
+}
+
+var overscrollValues = map[string]string{
+	"auto":    "auto",
+	"contain": "contain",
+	"none":    "none",
+}
+
+var overscroll = KeywordBaseClass{
+	name:     "overscroll",
+	property: "overscroll-behavior",
+	values:   overscrollValues,
+	order:    overscrollOrder,
+}
+
+var overscrollX = KeywordBaseClass{
+	name:     "overscroll-x",
+	property: "overscroll-behavior-x",
+	values:   overscrollValues,
+	order:    overscrollXOrder,
+}
+
+var overscrollY = KeywordBaseClass{
+	name:     "overscroll-y",
+	property: "overscroll-behavior-y",
+	values:   overscrollValues,
+	order:    overscrollYOrder,
+}
+
+var objectFit = KeywordBaseClass{
+	name:     "object",
+	property: "object-fit",
+	values: map[string]string{
+		"contain":    "contain",
+		"cover":      "cover",
+		"fill":       "fill",
+		"none":       "none",
+		"scale-down": "scale-down",
+	},
+	order: objectFitOrder,
+}
+
+var breakAfter = KeywordBaseClass{
+	name:     "break-after",
+	property: "break-after",
+	values: map[string]string{
+		"auto":       "auto",
+		"avoid":      "avoid",
+		"all":        "all",
+		"avoid-page": "avoid-page",
+		"page":       "page",
+		"left":       "left",
+		"right":      "right",
+		"column":     "column",
+	},
+	order: breakAfterOrder,
+}
+var breakBefore = KeywordBaseClass{
+	name:     "break-before",
+	property: "break-before",
+	values: map[string]string{
+		"auto":       "auto",
+		"avoid":      "avoid",
+		"all":        "all",
+		"avoid-page": "avoid-page",
+		"page":       "page",
+		"left":       "left",
+		"right":      "right",
+		"column":     "column",
+	},
+	order: breakBeforeOrder,
+}
+var breakInside = KeywordBaseClass{
+	name:     "break-inside",
+	property: "break-inside",
+	values: map[string]string{
+		"auto":         "auto",
+		"avoid":        "avoid",
+		"avoid-page":   "avoid-page",
+		"avoid-column": "avoid-column",
+	},
+	order: breakInsideOrder,
+}
+var boxDecoration = KeywordBaseClass{
+	name:     "box-decoration",
+	property: "box-decoration-break",
+	values: map[string]string{
+		"clone": "clone",
+		"slice": "slice",
+	},
+	order: boxDecorationOrder,
+}
+var boxSizing = KeywordBaseClass{
+	name:     "box",
+	property: "box-sizing",
+	values: map[string]string{
+		"border":  "border-box",
+		"content": "content-box",
 	},
 	order: boxSizingOrder,
 }
@@ -999,11 +2124,10 @@ var clear = KeywordBaseClass{
 	order: clearOrder,
 }
 
-var textWrap = KeywordBaseClass{
-	// TODO: check for duplicates
+var textWrap = ArbitraryValueKeywordClass{
 	name:     "text",
 	property: "text-wrap",
-	values: map[string]string{
+	defaults: map[string]string{
 		"wrap":    "wrap",
 		"nowrap":  "nowrap",
 		"balance": "balance",
@@ -1012,159 +2136,2822 @@ var textWrap = KeywordBaseClass{
 	order: textWrapOrder,
 }
 
+var textOverflow = KeywordBaseClass{
+	name:     "text",
+	property: "text-overflow",
+	values: map[string]string{
+		"ellipsis": "ellipsis",
+		"clip":     "clip",
+	},
+	order: textOverflowOrder,
+}
+
+var truncate = DeclarationsBaseClass{
+	name: "truncate",
+	keywords: map[string][]CSSDeclaration{
+		"": {
+			{"overflow", "hidden"},
+			{"text-overflow", "ellipsis"},
+			{"white-space", "nowrap"},
+		},
+	},
+	order: textOverflowOrder,
+}
+
+var hyphens = MultiPropertyKeywordClass{
+	name:       "hyphens",
+	properties: []string{"-webkit-hyphens", "hyphens"},
+	defaults: map[string]string{
+		"none":   "none",
+		"manual": "manual",
+		"auto":   "auto",
+	},
+	order: hyphensOrder,
+}
+
+var whitespace = KeywordBaseClass{
+	name:     "whitespace",
+	property: "white-space",
+	values: map[string]string{
+		"normal":       "normal",
+		"nowrap":       "nowrap",
+		"pre":          "pre",
+		"pre-line":     "pre-line",
+		"pre-wrap":     "pre-wrap",
+		"break-spaces": "break-spaces",
+	},
+	order: whitespaceOrder,
+}
+
+// break-normal resets both of the properties the others set
+var wordBreak = DeclarationsBaseClass{
+	name: "break",
+	keywords: map[string][]CSSDeclaration{
+		"normal": {{"overflow-wrap", "normal"}, {"word-break", "normal"}},
+		"words":  {{"overflow-wrap", "break-word"}},
+		"all":    {{"word-break", "break-all"}},
+		"keep":   {{"word-break", "keep-all"}},
+	},
+	order: wordBreakOrder,
+}
+
 // TODO: replace all instances of order: 0
 
-type RandomKeywordBaseClass struct {
-	keywords map[string]string
-	property string
-	order    int
+type RandomKeywordBaseClass struct {
+	keywords map[string]string
+	property string
+	order    int
+}
+
+func (r RandomKeywordBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range r.keywords {
+		m[k] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: r.property, Value: v}}},
+			r.order,
+		}
+	}
+	return m
+}
+
+var display = RandomKeywordBaseClass{
+	keywords: map[string]string{
+		"block":              "block",
+		"inline-block":       "inline-block",
+		"inline":             "inline",
+		"flex":               "flex",
+		"inline-flex":        "inline-flex",
+		"table":              "table",
+		"inline-table":       "inline-table",
+		"table-caption":      "table-caption",
+		"table-cell":         "table-cell",
+		"table-column":       "table-column",
+		"table-column-group": "table-column-group",
+		"table-footer-group": "table-footer-group",
+		"table-header-group": "table-header-group",
+		"table-row-group":    "table-row-group",
+		"table-row":          "table-row",
+		"flow-root":          "flow-root",
+		"grid":               "grid",
+		"inline-grid":        "inline-grid",
+		"contents":           "contents",
+		"list-item":          "list-item",
+		// the exception behind the duplication
+		"hidden": "none",
+	},
+	property: "display",
+	order:    displayOrder,
+}
+
+var position = RandomKeywordBaseClass{
+	keywords: map[string]string{
+		"static":   "static",
+		"fixed":    "fixed",
+		"absolute": "absolute",
+		"relative": "relative",
+		"sticky":   "sticky",
+	},
+	property: "position",
+	order:    positionOrder,
+}
+
+var visibility = RandomKeywordBaseClass{
+	keywords: map[string]string{
+		"visible":   "visible",
+		"invisible": "hidden",
+		"collapse":  "collapse",
+	},
+	property: "visibility",
+	order:    visibilityOrder,
+}
+
+var isolation = RandomKeywordBaseClass{
+	keywords: map[string]string{
+		"isolate":        "isolate",
+		"isolation-auto": "auto",
+	},
+	property: "isolation",
+	order:    isolationOrder,
+}
+
+type ArbitraryNumericalBaseClass struct {
+	name     string
+	property string
+	keywords map[string]string
+	order    int
+}
+
+func (a ArbitraryNumericalBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for _, num := range defaultNums {
+		n, err := strconv.ParseFloat(num, 64)
+		n = n / 4
+		// should not happen
+		if err != nil {
+			continue
+		}
+		m[a.name+"-"+num] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: fmt.Sprint(n) + "rem"}}},
+			a.order + numbersOrder,
+		}
+	}
+	for _, fraction := range defaultFractions {
+		n, err := parseFraction(fraction)
+		// should not happen
+		if err != nil {
+			continue
+		}
+		m[a.name+"-"+fraction] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: formatPercent(n)}}},
+			a.order + fractionsOrder,
+		}
+	}
+	for k, v := range a.keywords {
+		m[a.name+"-"+k] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: v}}},
+			a.order + keywordsOrder,
+		}
+	}
+	return m
+}
+
+func (a ArbitraryNumericalBaseClass) arbitraryValue(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: v}}},
+		a.order,
+	}
+}
+func (a ArbitraryNumericalBaseClass) baseForArbitraryValue() string {
+	return a.name
+}
+
+var flexBasis = ArbitraryNumericalBaseClass{
+	name:     "basis",
+	property: "flex-basis",
+	keywords: map[string]string{
+		"auto": "auto",
+		"full": "100%",
+	},
+	order: flexBasisOrder,
+}
+
+// SpacingBaseClass is like ArbitraryNumericalBaseClass except that it uses the theme spacing scale
+// and it can set multiple properties at once, px-4 sets both padding-left and padding-right
+type SpacingBaseClass struct {
+	name       string
+	properties []string
+	// makes the declarations instead of properties when they need more than the value, like space-x-4
+	declarations func(v string) []CSSDeclaration
+	keywords     map[string]string
+	// also generate name-1/2 etc. from defaultFractions
+	fractions bool
+	// also take -name-*, see NegativeValueClass
+	negative bool
+	// custom properties that the declarations need on every element
+	variables []CSSDeclaration
+	order     int
+}
+
+func (s SpacingBaseClass) valueDeclarations(v string) []CSSDeclaration {
+	if s.declarations != nil {
+		return s.declarations(v)
+	}
+	return declarationsFor(s.properties, v)
+}
+
+func (s SpacingBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range defaultSpacing {
+		m[s.name+"-"+k] = OrderedCSS{
+			CSS{Declarations: s.valueDeclarations(v), Variables: s.variables},
+			s.order + numbersOrder,
+		}
+	}
+	if s.fractions {
+		for _, fraction := range defaultFractions {
+			n, err := parseFraction(fraction)
+			// should not happen
+			if err != nil {
+				continue
+			}
+			m[s.name+"-"+fraction] = OrderedCSS{
+				CSS{Declarations: s.valueDeclarations(formatPercent(n)), Variables: s.variables},
+				s.order + fractionsOrder,
+			}
+		}
+	}
+	for k, v := range s.keywords {
+		m[s.name+"-"+k] = OrderedCSS{
+			CSS{Declarations: s.valueDeclarations(v), Variables: s.variables},
+			s.order + keywordsOrder,
+		}
+	}
+	return m
+}
+
+func (s SpacingBaseClass) arbitraryValue(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: s.valueDeclarations(v), Variables: s.variables},
+		s.order,
+	}
+}
+func (s SpacingBaseClass) baseForArbitraryValue() string {
+	return s.name
+}
+
+// the spacing scale, fractions and keywords that are lengths can be negative, -top-full but not -m-auto
+func (s SpacingBaseClass) negativeValue(value string, arbitrary bool) (OrderedCSS, bool) {
+	if !s.negative {
+		return OrderedCSS{}, false
+	}
+	if arbitrary {
+		return s.arbitraryValue(negate(value)), true
+	}
+	order := s.order + numbersOrder
+	v, ok := defaultSpacing[value]
+	if !ok && s.fractions && slices.Contains(defaultFractions, value) {
+		n, err := parseFraction(value)
+		if err != nil {
+			return OrderedCSS{}, false
+		}
+		v, ok = formatPercent(n), true
+		order = s.order + fractionsOrder
+	}
+	if k, isKeyword := s.keywords[value]; !ok && isKeyword && isLength(k) {
+		v, ok = k, true
+		order = s.order + keywordsOrder
+	}
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	return OrderedCSS{
+		CSS{Declarations: s.valueDeclarations(negate(v)), Variables: s.variables},
+		order,
+	}, true
+}
+
+// the suffixes for the classes that can target one side of the box, mx-4 ps-2 etc.
+var sides = []struct {
+	suffix string
+	sides  []string
+}{
+	{"", nil},
+	{"x", []string{"left", "right"}},
+	{"y", []string{"top", "bottom"}},
+	{"s", []string{"inline-start"}},
+	{"e", []string{"inline-end"}},
+	{"t", []string{"top"}},
+	{"r", []string{"right"}},
+	{"b", []string{"bottom"}},
+	{"l", []string{"left"}},
+}
+
+// makes p, px, py, ps, ... from "p" and "padding"
+func genSidedSpacing(name, property string, keywords map[string]string, negative bool, order int) []SpacingBaseClass {
+	arr := []SpacingBaseClass{}
+	for i, side := range sides {
+		properties := []string{property}
+		if side.sides != nil {
+			properties = []string{}
+			for _, s := range side.sides {
+				properties = append(properties, property+"-"+s)
+			}
+		}
+		arr = append(arr, SpacingBaseClass{
+			name:       name + side.suffix,
+			properties: properties,
+			keywords:   keywords,
+			negative:   negative,
+			order:      order + i*10,
+		})
+	}
+	return arr
+}
+
+// makes inset, inset-x, inset-y, start, end, top, right, bottom and left
+func genInsets() []SpacingBaseClass {
+	names := map[string]string{
+		"":  "inset",
+		"x": "inset-x",
+		"y": "inset-y",
+		"s": "start",
+		"e": "end",
+		"t": "top",
+		"r": "right",
+		"b": "bottom",
+		"l": "left",
+	}
+	arr := []SpacingBaseClass{}
+	for i, side := range sides {
+		properties := []string{"inset"}
+		if side.sides != nil {
+			properties = []string{}
+			for _, s := range side.sides {
+				if strings.HasPrefix(s, "inline") {
+					s = "inset-" + s
+				}
+				properties = append(properties, s)
+			}
+		}
+		arr = append(arr, SpacingBaseClass{
+			name:       names[side.suffix],
+			properties: properties,
+			keywords: map[string]string{
+				"auto": "auto",
+				"full": "100%",
+			},
+			fractions: true,
+			negative:  true,
+			order:     insetOrder + i*10,
+		})
+	}
+	return arr
+}
+
+var insets = genInsets()
+
+var paddings = genSidedSpacing("p", "padding", nil, false, paddingOrder)
+
+var margins = genSidedSpacing("m", "margin", map[string]string{"auto": "auto"}, true, marginOrder)
+
+var gap = SpacingBaseClass{
+	name:       "gap",
+	properties: []string{"gap"},
+	order:      gapOrder,
+}
+
+var gapX = SpacingBaseClass{
+	name:       "gap-x",
+	properties: []string{"column-gap"},
+	order:      gapOrder + 10,
+}
+
+var gapY = SpacingBaseClass{
+	name:       "gap-y",
+	properties: []string{"row-gap"},
+	order:      gapOrder + 20,
+}
+
+// the children of the element except for the first one, what space-x-4 and divide-x put their borders and margins on
+const betweenChildren = " > :not([hidden]) ~ :not([hidden])"
+
+// puts a class on the children of the element instead, see betweenChildren
+// it passes on whatever the class it wraps can do with arbitrary values, modifiers and negatives
+type ChildrenBaseClass struct {
+	class interface {
+		BaseClass
+		ArbitraryValueClass
+	}
+}
+
+func (c ChildrenBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := c.class.produceMap(config)
+	for k, v := range m {
+		v.SelectorSuffix = betweenChildren
+		m[k] = v
+	}
+	return m
+}
+
+func (c ChildrenBaseClass) arbitraryValue(v string) OrderedCSS {
+	css := c.class.arbitraryValue(v)
+	css.SelectorSuffix = betweenChildren
+	return css
+}
+func (c ChildrenBaseClass) baseForArbitraryValue() string {
+	return c.class.baseForArbitraryValue()
+}
+
+func (c ChildrenBaseClass) takesValue(t, v string) bool {
+	typed, ok := c.class.(TypedArbitraryValueClass)
+	return ok && typed.takesValue(t, v)
+}
+
+func (c ChildrenBaseClass) modifiedValue(value string, arbitrary bool, modifier string) (OrderedCSS, bool) {
+	m, ok := c.class.(ModifierValueClass)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	css, ok := m.modifiedValue(value, arbitrary, modifier)
+	css.SelectorSuffix = betweenChildren
+	return css, ok
+}
+
+func (c ChildrenBaseClass) negativeValue(value string, arbitrary bool) (OrderedCSS, bool) {
+	n, ok := c.class.(NegativeValueClass)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	css, ok := n.negativeValue(value, arbitrary)
+	css.SelectorSuffix = betweenChildren
+	return css, ok
+}
+
+// puts the value on the start side of every child, or the end side once the reverse variable is set to 1 for flex-row-reverse
+func reversibleDeclarations(reverse, start, end string) func(v string) []CSSDeclaration {
+	return func(v string) []CSSDeclaration {
+		return []CSSDeclaration{
+			{reverse, "0"},
+			{end, "calc(" + v + " * var(" + reverse + "))"},
+			{start, "calc(" + v + " * calc(1 - var(" + reverse + ")))"},
+		}
+	}
+}
+
+var spaces = []ChildrenBaseClass{
+	{SpacingBaseClass{
+		name:         "space-x",
+		declarations: reversibleDeclarations("--tw-space-x-reverse", "margin-left", "margin-right"),
+		negative:     true,
+		order:        spaceOrder,
+	}},
+	{SpacingBaseClass{
+		name:         "space-y",
+		declarations: reversibleDeclarations("--tw-space-y-reverse", "margin-top", "margin-bottom"),
+		negative:     true,
+		order:        spaceOrder + 10,
+	}},
+}
+
+// space-x-reverse and space-y-reverse, they have no arbitrary values so they are not in spaces
+var spaceReverses = []ChildrenBaseClass{
+	{DeclarationsBaseClass{
+		name: "space-x",
+		keywords: map[string][]CSSDeclaration{
+			"reverse": {{"--tw-space-x-reverse", "1"}},
+		},
+		order: spaceOrder + 20,
+	}},
+	{DeclarationsBaseClass{
+		name: "space-y",
+		keywords: map[string][]CSSDeclaration{
+			"reverse": {{"--tw-space-y-reverse", "1"}},
+		},
+		order: spaceOrder + 30,
+	}},
+}
+
+func divideWidths(declarations func(v string) []CSSDeclaration) map[string][]CSSDeclaration {
+	m := map[string][]CSSDeclaration{}
+	for k, v := range map[string]string{"": "1px", "0": "0px", "2": "2px", "4": "4px", "8": "8px"} {
+		m[k] = declarations(v)
+	}
+	return m
+}
+
+var divideXDeclarations = reversibleDeclarations("--tw-divide-x-reverse", "border-left-width", "border-right-width")
+var divideYDeclarations = reversibleDeclarations("--tw-divide-y-reverse", "border-top-width", "border-bottom-width")
+
+// the color and the style come first so that they get divide-[...] when the value is not a width
+var divides = []ChildrenBaseClass{
+	{ArbitraryColorBaseClass{
+		name:     "divide",
+		property: "border-color",
+		order:    divideColorOrder,
+	}},
+	{ArbitraryValueKeywordClass{
+		name:     "divide",
+		property: "border-style",
+		defaults: map[string]string{
+			"solid":  "solid",
+			"dashed": "dashed",
+			"dotted": "dotted",
+			"double": "double",
+			"none":   "none",
+		},
+		order: divideStyleOrder,
+	}},
+	{DeclarationsBaseClass{
+		name:      "divide-x",
+		keywords:  divideWidths(divideXDeclarations),
+		arbitrary: divideXDeclarations,
+		order:     divideWidthOrder,
+	}},
+	{DeclarationsBaseClass{
+		name:      "divide-y",
+		keywords:  divideWidths(divideYDeclarations),
+		arbitrary: divideYDeclarations,
+		order:     divideWidthOrder + 10,
+	}},
+}
+
+// divide-x-reverse and divide-y-reverse, they have no arbitrary values so they are not in divides
+var divideReverses = []ChildrenBaseClass{
+	{DeclarationsBaseClass{
+		name: "divide-x",
+		keywords: map[string][]CSSDeclaration{
+			"reverse": {{"--tw-divide-x-reverse", "1"}},
+		},
+		order: divideWidthOrder + 20,
+	}},
+	{DeclarationsBaseClass{
+		name: "divide-y",
+		keywords: map[string][]CSSDeclaration{
+			"reverse": {{"--tw-divide-y-reverse", "1"}},
+		},
+		order: divideWidthOrder + 30,
+	}},
+}
+
+var width = SpacingBaseClass{
+	name:       "w",
+	properties: []string{"width"},
+	keywords: map[string]string{
+		"auto":   "auto",
+		"full":   "100%",
+		"screen": "100vw",
+		"svw":    "100svw",
+		"lvw":    "100lvw",
+		"dvw":    "100dvw",
+		"min":    "min-content",
+		"max":    "max-content",
+		"fit":    "fit-content",
+	},
+	fractions: true,
+	order:     widthOrder,
+}
+
+var minWidth = SpacingBaseClass{
+	name:       "min-w",
+	properties: []string{"min-width"},
+	keywords: map[string]string{
+		"full": "100%",
+		"min":  "min-content",
+		"max":  "max-content",
+		"fit":  "fit-content",
+	},
+	order: minWidthOrder,
+}
+
+var maxWidth = SpacingBaseClass{
+	name:       "max-w",
+	properties: []string{"max-width"},
+	keywords: map[string]string{
+		"none":  "none",
+		"xs":    "20rem",
+		"sm":    "24rem",
+		"md":    "28rem",
+		"lg":    "32rem",
+		"xl":    "36rem",
+		"2xl":   "42rem",
+		"3xl":   "48rem",
+		"4xl":   "56rem",
+		"5xl":   "64rem",
+		"6xl":   "72rem",
+		"7xl":   "80rem",
+		"full":  "100%",
+		"min":   "min-content",
+		"max":   "max-content",
+		"fit":   "fit-content",
+		"prose": "65ch",
+	},
+	order: maxWidthOrder,
+}
+
+var maxWidthScreens = BreakpointsBaseClass{
+	name:     "max-w-screen",
+	property: "max-width",
+	order:    maxWidthOrder,
+}
+
+var container = ContainerBaseClass{}
+
+var height = SpacingBaseClass{
+	name:       "h",
+	properties: []string{"height"},
+	keywords: map[string]string{
+		"auto":   "auto",
+		"full":   "100%",
+		"screen": "100vh",
+		"svh":    "100svh",
+		"lvh":    "100lvh",
+		"dvh":    "100dvh",
+		"min":    "min-content",
+		"max":    "max-content",
+		"fit":    "fit-content",
+	},
+	fractions: true,
+	order:     heightOrder,
+}
+
+var minHeight = SpacingBaseClass{
+	name:       "min-h",
+	properties: []string{"min-height"},
+	keywords: map[string]string{
+		"full":   "100%",
+		"screen": "100vh",
+		"svh":    "100svh",
+		"lvh":    "100lvh",
+		"dvh":    "100dvh",
+		"min":    "min-content",
+		"max":    "max-content",
+		"fit":    "fit-content",
+	},
+	order: minHeightOrder,
+}
+
+var maxHeight = SpacingBaseClass{
+	name:       "max-h",
+	properties: []string{"max-height"},
+	keywords: map[string]string{
+		"none":   "none",
+		"full":   "100%",
+		"screen": "100vh",
+		"svh":    "100svh",
+		"lvh":    "100lvh",
+		"dvh":    "100dvh",
+		"min":    "min-content",
+		"max":    "max-content",
+		"fit":    "fit-content",
+	},
+	order: maxHeightOrder,
+}
+
+var size = SpacingBaseClass{
+	name:       "size",
+	properties: []string{"width", "height"},
+	keywords: map[string]string{
+		"auto": "auto",
+		"full": "100%",
+		"min":  "min-content",
+		"max":  "max-content",
+		"fit":  "fit-content",
+	},
+	fractions: true,
+	order:     sizeOrder,
+}
+
+// makes a class for every breakpoint, looks at defaultBreakpoints when it is called so it follows the config
+type BreakpointsBaseClass struct {
+	name     string
+	property string
+	order    int
+}
+
+func (b BreakpointsBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range defaultBreakpoints {
+		m[b.name+"-"+k] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: b.property, Value: v}}},
+			b.order,
+		}
+	}
+	return m
+}
+
+// container is unique, it is width 100% and then a max width for every screen
+// looks at defaultBreakpoints and defaultContainer when it is called so it follows the config
+type ContainerBaseClass struct{}
+
+func (c ContainerBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	css := CSS{Declarations: []CSSDeclaration{{"width", "100%"}}}
+	if defaultContainer.Center {
+		css.Declarations = append(css.Declarations, CSSDeclaration{"margin-right", "auto"}, CSSDeclaration{"margin-left", "auto"})
+	}
+	if p, ok := defaultContainer.Padding["DEFAULT"]; ok {
+		css.Declarations = append(css.Declarations, CSSDeclaration{"padding-right", p}, CSSDeclaration{"padding-left", p})
+	}
+	for _, k := range sortedBreakpoints() {
+		v := defaultBreakpoints[k]
+		r := CSS{
+			MediaQueries: []string{"min-width: " + v},
+			Declarations: []CSSDeclaration{{"max-width", v}},
+		}
+		if p, ok := defaultContainer.Padding[k]; ok {
+			r.Declarations = append(r.Declarations, CSSDeclaration{"padding-right", p}, CSSDeclaration{"padding-left", p})
+		}
+		css.Rules = append(css.Rules, r)
+	}
+	return map[string]OrderedCSS{"container": {css, containerOrder}}
+}
+
+// like ArbitraryValueKeywordClass but sets every property in properties, rounded-t-lg sets two corners
+type MultiPropertyKeywordClass struct {
+	name       string
+	properties []string
+	defaults   map[string]string
+	// also take -name-* for the defaults, see NegativeValueClass
+	negative bool
+	order    int
+}
+
+func (a MultiPropertyKeywordClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range a.defaults {
+		n := a.name
+		if k != "" {
+			n += "-" + k
+		}
+		m[n] = OrderedCSS{
+			CSS{Declarations: declarationsFor(a.properties, v)},
+			a.order,
+		}
+	}
+	return m
+}
+
+func (a MultiPropertyKeywordClass) arbitraryValue(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: declarationsFor(a.properties, v)},
+		a.order,
+	}
+}
+func (a MultiPropertyKeywordClass) takesValue(t, v string) bool {
+	return t == "length" || t == "percentage"
+}
+func (a MultiPropertyKeywordClass) baseForArbitraryValue() string {
+	return a.name
+}
+
+func (a MultiPropertyKeywordClass) negativeValue(value string, arbitrary bool) (OrderedCSS, bool) {
+	if !a.negative {
+		return OrderedCSS{}, false
+	}
+	if !arbitrary {
+		v, ok := a.defaults[value]
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		value = v
+	}
+	return a.arbitraryValue(negate(value)), true
+}
+
+// makes border, border-x, border-t, ... with border-width, border-left-width, ...
+func genBorderWidths() []MultiPropertyKeywordClass {
+	arr := []MultiPropertyKeywordClass{}
+	for i, side := range sides {
+		name := "border"
+		properties := []string{"border-width"}
+		if side.sides != nil {
+			name += "-" + side.suffix
+			properties = []string{}
+			for _, s := range side.sides {
+				properties = append(properties, "border-"+s+"-width")
+			}
+		}
+		arr = append(arr, MultiPropertyKeywordClass{
+			name:       name,
+			properties: properties,
+			defaults: map[string]string{
+				"":  "1px",
+				"0": "0px",
+				"2": "2px",
+				"4": "4px",
+				"8": "8px",
+			},
+			order: borderWidthOrder + i*10,
+		})
+	}
+	return arr
+}
+
+var borderWidths = genBorderWidths()
+
+var borderStyle = KeywordBaseClass{
+	name:     "border",
+	property: "border-style",
+	values: map[string]string{
+		"solid":  "solid",
+		"dashed": "dashed",
+		"dotted": "dotted",
+		"double": "double",
+		"hidden": "hidden",
+		"none":   "none",
+	},
+	order: borderStyleOrder,
+}
+
+// the suffixes for the classes that can target some of the corners of the box, rounded-t rounded-ss etc.
+var corners = []struct {
+	suffix  string
+	corners []string
+}{
+	{"", nil},
+	{"s", []string{"start-start", "end-start"}},
+	{"e", []string{"start-end", "end-end"}},
+	{"t", []string{"top-left", "top-right"}},
+	{"r", []string{"top-right", "bottom-right"}},
+	{"b", []string{"bottom-right", "bottom-left"}},
+	{"l", []string{"top-left", "bottom-left"}},
+	{"ss", []string{"start-start"}},
+	{"se", []string{"start-end"}},
+	{"ee", []string{"end-end"}},
+	{"es", []string{"end-start"}},
+	{"tl", []string{"top-left"}},
+	{"tr", []string{"top-right"}},
+	{"br", []string{"bottom-right"}},
+	{"bl", []string{"bottom-left"}},
+}
+
+// makes rounded, rounded-t, rounded-tl, ... with border-radius, border-top-left-radius, ...
+func genBorderRadiuses() []MultiPropertyKeywordClass {
+	arr := []MultiPropertyKeywordClass{}
+	for i, corner := range corners {
+		name := "rounded"
+		properties := []string{"border-radius"}
+		if corner.corners != nil {
+			name += "-" + corner.suffix
+			properties = []string{}
+			for _, c := range corner.corners {
+				properties = append(properties, "border-"+c+"-radius")
+			}
+		}
+		arr = append(arr, MultiPropertyKeywordClass{
+			name:       name,
+			properties: properties,
+			defaults: map[string]string{
+				"none": "0px",
+				"sm":   "0.125rem",
+				"":     "0.25rem",
+				"md":   "0.375rem",
+				"lg":   "0.5rem",
+				"xl":   "0.75rem",
+				"2xl":  "1rem",
+				"3xl":  "1.5rem",
+				"full": "9999px",
+			},
+			// there are too many corners to fit 10 apart
+			order: borderRadiusOrder + i*5,
+		})
+	}
+	return arr
+}
+
+var borderRadiuses = genBorderRadiuses()
+
+// for classes that need more than one declaration, shadow-md sets the shadow variables and the box-shadow
+type DeclarationsBaseClass struct {
+	name string
+	// "" is the class with just the name
+	keywords map[string][]CSSDeclaration
+	// makes the declarations for an arbitrary value
+	arbitrary func(v string) []CSSDeclaration
+	// custom properties that the declarations need on every element
+	variables []CSSDeclaration
+	// the arbitrary value types it takes when it shares its name, see TypedArbitraryValueClass
+	types []string
+	order int
+}
+
+func (d DeclarationsBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range d.keywords {
+		n := d.name
+		if k != "" {
+			n += "-" + k
+		}
+		m[n] = OrderedCSS{
+			CSS{Declarations: v, Variables: d.variables},
+			d.order,
+		}
+	}
+	return m
+}
+
+func (d DeclarationsBaseClass) arbitraryValue(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: d.arbitrary(v), Variables: d.variables},
+		d.order,
+	}
+}
+func (d DeclarationsBaseClass) baseForArbitraryValue() string {
+	return d.name
+}
+func (d DeclarationsBaseClass) takesValue(t, v string) bool {
+	return slices.Contains(d.types, t)
+}
+
+// how tailwind sets a variable to nothing, a bare --tw-ring-inset: ; does not survive minifiers
+// --tw-empty is never set and the /*!*/ comments are kept so the fallback stays a space
+const emptyValue = "var(--tw-empty,/*!*/ /*!*/)"
+
+// every shadow and ring class reads all of these so that they can be stacked on one element
+var shadowVariables = []CSSDeclaration{
+	{"--tw-ring-inset", emptyValue},
+	{"--tw-ring-offset-width", "0px"},
+	{"--tw-ring-offset-color", "#fff"},
+	{"--tw-ring-color", "rgb(59 130 246 / 0.5)"},
+	{"--tw-ring-offset-shadow", "0 0 #0000"},
+	{"--tw-ring-shadow", "0 0 #0000"},
+	{"--tw-shadow", "0 0 #0000"},
+	{"--tw-shadow-colored", "0 0 #0000"},
+}
+
+func shadowDeclarations(shadow string) []CSSDeclaration {
+	colored := []string{}
+	for _, layer := range splitTopLevel(shadow, ',') {
+		parts := splitTopLevel(strings.TrimSpace(layer), ' ')
+		for i, part := range parts {
+			if isColor(part) {
+				parts[i] = "var(--tw-shadow-color)"
+			}
+		}
+		colored = append(colored, strings.Join(parts, " "))
+	}
+	return []CSSDeclaration{
+		{"--tw-shadow", shadow},
+		{"--tw-shadow-colored", strings.Join(colored, ", ")},
+		{"box-shadow", "var(--tw-ring-offset-shadow, 0 0 #0000), var(--tw-ring-shadow, 0 0 #0000), var(--tw-shadow)"},
+	}
+}
+
+var boxShadow = DeclarationsBaseClass{
+	name: "shadow",
+	keywords: map[string][]CSSDeclaration{
+		"sm":    shadowDeclarations("0 1px 2px 0 rgb(0 0 0 / 0.05)"),
+		"":      shadowDeclarations("0 1px 3px 0 rgb(0 0 0 / 0.1), 0 1px 2px -1px rgb(0 0 0 / 0.1)"),
+		"md":    shadowDeclarations("0 4px 6px -1px rgb(0 0 0 / 0.1), 0 2px 4px -2px rgb(0 0 0 / 0.1)"),
+		"lg":    shadowDeclarations("0 10px 15px -3px rgb(0 0 0 / 0.1), 0 4px 6px -4px rgb(0 0 0 / 0.1)"),
+		"xl":    shadowDeclarations("0 20px 25px -5px rgb(0 0 0 / 0.1), 0 8px 10px -6px rgb(0 0 0 / 0.1)"),
+		"2xl":   shadowDeclarations("0 25px 50px -12px rgb(0 0 0 / 0.25)"),
+		"inner": shadowDeclarations("inset 0 2px 4px 0 rgb(0 0 0 / 0.05)"),
+		"none": {
+			{"--tw-shadow", "0 0 #0000"},
+			{"--tw-shadow-colored", "0 0 #0000"},
+			{"box-shadow", "var(--tw-ring-offset-shadow, 0 0 #0000), var(--tw-ring-shadow, 0 0 #0000), var(--tw-shadow)"},
+		},
+	},
+	arbitrary: shadowDeclarations,
+	variables: shadowVariables,
+	order:     boxShadowOrder,
+}
+
+func ringDeclarations(width string) []CSSDeclaration {
+	return []CSSDeclaration{
+		{"--tw-ring-offset-shadow", "var(--tw-ring-inset) 0 0 0 var(--tw-ring-offset-width) var(--tw-ring-offset-color)"},
+		{"--tw-ring-shadow", "var(--tw-ring-inset) 0 0 0 calc(" + width + " + var(--tw-ring-offset-width)) var(--tw-ring-color)"},
+		{"box-shadow", "var(--tw-ring-offset-shadow), var(--tw-ring-shadow), var(--tw-shadow, 0 0 #0000)"},
+	}
+}
+
+var ringWidth = DeclarationsBaseClass{
+	name: "ring",
+	keywords: map[string][]CSSDeclaration{
+		"0":     ringDeclarations("0px"),
+		"1":     ringDeclarations("1px"),
+		"2":     ringDeclarations("2px"),
+		"":      ringDeclarations("3px"),
+		"4":     ringDeclarations("4px"),
+		"8":     ringDeclarations("8px"),
+		"inset": {{"--tw-ring-inset", "inset"}},
+	},
+	arbitrary: ringDeclarations,
+	variables: shadowVariables,
+	types:     []string{"length"},
+	order:     ringWidthOrder,
+}
+
+var ringOffsetWidth = MultiPropertyKeywordClass{
+	name:       "ring-offset",
+	properties: []string{"--tw-ring-offset-width"},
+	defaults: map[string]string{
+		"0": "0px",
+		"1": "1px",
+		"2": "2px",
+		"4": "4px",
+		"8": "8px",
+	},
+	negative: true,
+	order:    ringOffsetWidthOrder,
+}
+
+var outlineStyle = DeclarationsBaseClass{
+	name: "outline",
+	keywords: map[string][]CSSDeclaration{
+		"none":   {{"outline", "2px solid transparent"}, {"outline-offset", "2px"}},
+		"":       {{"outline-style", "solid"}},
+		"dashed": {{"outline-style", "dashed"}},
+		"dotted": {{"outline-style", "dotted"}},
+		"double": {{"outline-style", "double"}},
+	},
+	order: outlineStyleOrder,
+}
+
+var outlineWidth = MultiPropertyKeywordClass{
+	name:       "outline",
+	properties: []string{"outline-width"},
+	defaults: map[string]string{
+		"0": "0px",
+		"1": "1px",
+		"2": "2px",
+		"4": "4px",
+		"8": "8px",
+	},
+	order: outlineWidthOrder,
+}
+
+var outlineOffset = MultiPropertyKeywordClass{
+	name:       "outline-offset",
+	properties: []string{"outline-offset"},
+	defaults: map[string]string{
+		"0": "0px",
+		"1": "1px",
+		"2": "2px",
+		"4": "4px",
+		"8": "8px",
+	},
+	negative: true,
+	order:    outlineOffsetOrder,
+}
+
+// what transform gets set to by every transform class, the classes only change the variables
+const transformValue = "translate(var(--tw-translate-x), var(--tw-translate-y)) rotate(var(--tw-rotate)) skewX(var(--tw-skew-x)) skewY(var(--tw-skew-y)) scaleX(var(--tw-scale-x)) scaleY(var(--tw-scale-y))"
+
+var transformVariables = []CSSDeclaration{
+	{"--tw-translate-x", "0"},
+	{"--tw-translate-y", "0"},
+	{"--tw-rotate", "0"},
+	{"--tw-skew-x", "0"},
+	{"--tw-skew-y", "0"},
+	{"--tw-scale-x", "1"},
+	{"--tw-scale-y", "1"},
+}
+
+// transform classes set their own variables and then all of them write the same transform
+// that way rotate-45 and scale-50 on one element both apply instead of the last one winning
+type TransformBaseClass struct {
+	name string
+	// scale-50 sets both --tw-scale-x and --tw-scale-y
+	variables []string
+	values    map[string]string
+	// also use the theme spacing scale and fractions, like translate-x-4 and translate-x-1/2
+	spacing bool
+	// also take -name-*, see NegativeValueClass
+	negative bool
+	order    int
+}
+
+func (t TransformBaseClass) css(v string) OrderedCSS {
+	decls := append(declarationsFor(t.variables, v), CSSDeclaration{"transform", transformValue})
+	return OrderedCSS{
+		CSS{Declarations: decls, Variables: transformVariables},
+		t.order,
+	}
+}
+
+func (t TransformBaseClass) allValues() map[string]string {
+	values := maps.Clone(t.values)
+	if t.spacing {
+		values = concatMaps(values, defaultSpacing)
+		for _, fraction := range defaultFractions {
+			n, err := parseFraction(fraction)
+			// should not happen
+			if err != nil {
+				continue
+			}
+			values[fraction] = formatPercent(n)
+		}
+	}
+	return values
+}
+
+func (t TransformBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range t.allValues() {
+		m[t.name+"-"+k] = t.css(v)
+	}
+	return m
+}
+
+func (t TransformBaseClass) negativeValue(value string, arbitrary bool) (OrderedCSS, bool) {
+	if !t.negative {
+		return OrderedCSS{}, false
+	}
+	if !arbitrary {
+		v, ok := t.allValues()[value]
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		value = v
+	}
+	return t.css(negate(value)), true
+}
+
+func (t TransformBaseClass) arbitraryValue(v string) OrderedCSS {
+	return t.css(v)
+}
+func (t TransformBaseClass) baseForArbitraryValue() string {
+	return t.name
+}
+
+var rotateValues = map[string]string{
+	"0":   "0deg",
+	"1":   "1deg",
+	"2":   "2deg",
+	"3":   "3deg",
+	"6":   "6deg",
+	"12":  "12deg",
+	"45":  "45deg",
+	"90":  "90deg",
+	"180": "180deg",
+}
+
+var skewValues = map[string]string{
+	"0":  "0deg",
+	"1":  "1deg",
+	"2":  "2deg",
+	"3":  "3deg",
+	"6":  "6deg",
+	"12": "12deg",
+}
+
+var scaleValues = map[string]string{
+	"0":   "0",
+	"50":  ".5",
+	"75":  ".75",
+	"90":  ".9",
+	"95":  ".95",
+	"100": "1",
+	"105": "1.05",
+	"110": "1.1",
+	"125": "1.25",
+	"150": "1.5",
+}
+
+var transforms = []TransformBaseClass{
+	{
+		name:      "translate-x",
+		variables: []string{"--tw-translate-x"},
+		values:    map[string]string{"full": "100%"},
+		spacing:   true,
+		negative:  true,
+		order:     translateOrder,
+	},
+	{
+		name:      "translate-y",
+		variables: []string{"--tw-translate-y"},
+		values:    map[string]string{"full": "100%"},
+		spacing:   true,
+		negative:  true,
+		order:     translateOrder + 10,
+	},
+	{
+		name:      "rotate",
+		variables: []string{"--tw-rotate"},
+		values:    rotateValues,
+		negative:  true,
+		order:     rotateOrder,
+	},
+	{
+		name:      "skew-x",
+		variables: []string{"--tw-skew-x"},
+		values:    skewValues,
+		negative:  true,
+		order:     skewOrder,
+	},
+	{
+		name:      "skew-y",
+		variables: []string{"--tw-skew-y"},
+		values:    skewValues,
+		negative:  true,
+		order:     skewOrder + 10,
+	},
+	{
+		name:      "scale",
+		variables: []string{"--tw-scale-x", "--tw-scale-y"},
+		values:    scaleValues,
+		negative:  true,
+		order:     scaleOrder,
+	},
+	{
+		name:      "scale-x",
+		variables: []string{"--tw-scale-x"},
+		values:    scaleValues,
+		negative:  true,
+		order:     scaleOrder + 10,
+	},
+	{
+		name:      "scale-y",
+		variables: []string{"--tw-scale-y"},
+		values:    scaleValues,
+		negative:  true,
+		order:     scaleOrder + 20,
+	},
+}
+
+var transform = DeclarationsBaseClass{
+	name: "transform",
+	keywords: map[string][]CSSDeclaration{
+		"":     {{"transform", transformValue}},
+		"cpu":  {{"transform", transformValue}},
+		"gpu":  {{"transform", strings.Replace(transformValue, "translate(var(--tw-translate-x), var(--tw-translate-y))", "translate3d(var(--tw-translate-x), var(--tw-translate-y), 0)", 1)}},
+		"none": {{"transform", "none"}},
+	},
+	variables: transformVariables,
+	order:     transformOrder,
+}
+
+var transformOrigin = ArbitraryValueKeywordClass{
+	name:     "origin",
+	property: "transform-origin",
+	defaults: map[string]string{
+		"center":       "center",
+		"top":          "top",
+		"top-right":    "top right",
+		"right":        "right",
+		"bottom-right": "bottom right",
+		"bottom":       "bottom",
+		"bottom-left":  "bottom left",
+		"left":         "left",
+		"top-left":     "top left",
+	},
+	order: transformOriginOrder,
+}
+
+// filter and backdrop-filter compose the same way transform does, each class only sets its variable
+const filterValue = "var(--tw-blur) var(--tw-brightness) var(--tw-contrast) var(--tw-grayscale) var(--tw-hue-rotate) var(--tw-invert) var(--tw-saturate) var(--tw-sepia) var(--tw-drop-shadow)"
+
+const backdropFilterValue = "var(--tw-backdrop-blur) var(--tw-backdrop-brightness) var(--tw-backdrop-contrast) var(--tw-backdrop-grayscale) var(--tw-backdrop-hue-rotate) var(--tw-backdrop-invert) var(--tw-backdrop-opacity) var(--tw-backdrop-saturate) var(--tw-backdrop-sepia)"
+
+// the variables start out empty so the functions that aren't used drop out of the filter
+var filterVariables = []CSSDeclaration{
+	{"--tw-blur", emptyValue},
+	{"--tw-brightness", emptyValue},
+	{"--tw-contrast", emptyValue},
+	{"--tw-grayscale", emptyValue},
+	{"--tw-hue-rotate", emptyValue},
+	{"--tw-invert", emptyValue},
+	{"--tw-saturate", emptyValue},
+	{"--tw-sepia", emptyValue},
+	{"--tw-drop-shadow", emptyValue},
+}
+
+var backdropFilterVariables = []CSSDeclaration{
+	{"--tw-backdrop-blur", emptyValue},
+	{"--tw-backdrop-brightness", emptyValue},
+	{"--tw-backdrop-contrast", emptyValue},
+	{"--tw-backdrop-grayscale", emptyValue},
+	{"--tw-backdrop-hue-rotate", emptyValue},
+	{"--tw-backdrop-invert", emptyValue},
+	{"--tw-backdrop-opacity", emptyValue},
+	{"--tw-backdrop-saturate", emptyValue},
+	{"--tw-backdrop-sepia", emptyValue},
+}
+
+type FilterBaseClass struct {
+	// also the name of the variable, backdrop-blur sets --tw-backdrop-blur
+	name string
+	// the css filter function the values are passed to
+	function string
+	values   map[string]string
+	// write backdrop-filter instead of filter
+	backdrop bool
+	// also take -name-*, see NegativeValueClass
+	negative bool
+	order    int
+}
+
+// every top level comma separated value gets its own function, that is how drop-shadow-md has two shadows
+func (f FilterBaseClass) css(v string) OrderedCSS {
+	fns := []string{}
+	for _, part := range splitTopLevel(v, ',') {
+		fns = append(fns, f.function+"("+strings.TrimSpace(part)+")")
+	}
+	decls := []CSSDeclaration{{"--tw-" + f.name, strings.Join(fns, " ")}}
+	vars := filterVariables
+	if f.backdrop {
+		decls = append(decls,
+			CSSDeclaration{"-webkit-backdrop-filter", backdropFilterValue},
+			CSSDeclaration{"backdrop-filter", backdropFilterValue})
+		vars = backdropFilterVariables
+	} else {
+		decls = append(decls, CSSDeclaration{"filter", filterValue})
+	}
+	return OrderedCSS{
+		CSS{Declarations: decls, Variables: vars},
+		f.order,
+	}
+}
+
+func (f FilterBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range f.values {
+		name := f.name + "-" + k
+		if k == "" {
+			name = f.name
+		}
+		m[name] = f.css(v)
+	}
+	return m
+}
+
+func (f FilterBaseClass) negativeValue(value string, arbitrary bool) (OrderedCSS, bool) {
+	if !f.negative {
+		return OrderedCSS{}, false
+	}
+	if !arbitrary {
+		v, ok := f.values[value]
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		value = v
+	}
+	return f.css(negate(value)), true
+}
+
+func (f FilterBaseClass) arbitraryValue(v string) OrderedCSS {
+	return f.css(v)
+}
+func (f FilterBaseClass) baseForArbitraryValue() string {
+	return f.name
+}
+
+var blurValues = map[string]string{
+	"none": "0",
+	"sm":   "4px",
+	"":     "8px",
+	"md":   "12px",
+	"lg":   "16px",
+	"xl":   "24px",
+	"2xl":  "40px",
+	"3xl":  "64px",
+}
+
+var brightnessValues = map[string]string{
+	"0":   "0",
+	"50":  ".5",
+	"75":  ".75",
+	"90":  ".9",
+	"95":  ".95",
+	"100": "1",
+	"105": "1.05",
+	"110": "1.1",
+	"125": "1.25",
+	"150": "1.5",
+	"200": "2",
+}
+
+var contrastValues = map[string]string{
+	"0":   "0",
+	"50":  ".5",
+	"75":  ".75",
+	"100": "1",
+	"125": "1.25",
+	"150": "1.5",
+	"200": "2",
+}
+
+var saturateValues = map[string]string{
+	"0":   "0",
+	"50":  ".5",
+	"100": "1",
+	"150": "1.5",
+	"200": "2",
+}
+
+var hueRotateValues = map[string]string{
+	"0":   "0deg",
+	"15":  "15deg",
+	"30":  "30deg",
+	"60":  "60deg",
+	"90":  "90deg",
+	"180": "180deg",
+}
+
+// grayscale, invert and sepia are either all the way on or off
+var fullFilterValues = map[string]string{
+	"0": "0",
+	"":  "100%",
+}
+
+var dropShadowValues = map[string]string{
+	"sm":   "0 1px 1px rgb(0 0 0 / 0.05)",
+	"":     "0 1px 2px rgb(0 0 0 / 0.1), 0 1px 1px rgb(0 0 0 / 0.06)",
+	"md":   "0 4px 3px rgb(0 0 0 / 0.07), 0 2px 2px rgb(0 0 0 / 0.06)",
+	"lg":   "0 10px 8px rgb(0 0 0 / 0.04), 0 4px 3px rgb(0 0 0 / 0.1)",
+	"xl":   "0 20px 13px rgb(0 0 0 / 0.03), 0 8px 5px rgb(0 0 0 / 0.08)",
+	"2xl":  "0 25px 25px rgb(0 0 0 / 0.15)",
+	"none": "0 0 #0000",
+}
+
+var backdropOpacityValues = map[string]string{
+	"0":   "0",
+	"5":   "0.05",
+	"10":  "0.1",
+	"15":  "0.15",
+	"20":  "0.2",
+	"25":  "0.25",
+	"30":  "0.3",
+	"35":  "0.35",
+	"40":  "0.4",
+	"45":  "0.45",
+	"50":  "0.5",
+	"55":  "0.55",
+	"60":  "0.6",
+	"65":  "0.65",
+	"70":  "0.7",
+	"75":  "0.75",
+	"80":  "0.8",
+	"85":  "0.85",
+	"90":  "0.9",
+	"95":  "0.95",
+	"100": "1",
+}
+
+var filters = []FilterBaseClass{
+	{name: "blur", function: "blur", values: blurValues, order: filterFunctionsOrder},
+	{name: "brightness", function: "brightness", values: brightnessValues, order: filterFunctionsOrder + 10},
+	{name: "contrast", function: "contrast", values: contrastValues, order: filterFunctionsOrder + 20},
+	{name: "drop-shadow", function: "drop-shadow", values: dropShadowValues, order: filterFunctionsOrder + 30},
+	{name: "grayscale", function: "grayscale", values: fullFilterValues, order: filterFunctionsOrder + 40},
+	{name: "hue-rotate", function: "hue-rotate", values: hueRotateValues, negative: true, order: filterFunctionsOrder + 50},
+	{name: "invert", function: "invert", values: fullFilterValues, order: filterFunctionsOrder + 60},
+	{name: "saturate", function: "saturate", values: saturateValues, order: filterFunctionsOrder + 70},
+	{name: "sepia", function: "sepia", values: fullFilterValues, order: filterFunctionsOrder + 80},
+}
+
+var backdropFilters = []FilterBaseClass{
+	{name: "backdrop-blur", function: "blur", values: blurValues, backdrop: true, order: backdropFilterFunctionsOrder},
+	{name: "backdrop-brightness", function: "brightness", values: brightnessValues, backdrop: true, order: backdropFilterFunctionsOrder + 10},
+	{name: "backdrop-contrast", function: "contrast", values: contrastValues, backdrop: true, order: backdropFilterFunctionsOrder + 20},
+	{name: "backdrop-grayscale", function: "grayscale", values: fullFilterValues, backdrop: true, order: backdropFilterFunctionsOrder + 30},
+	{name: "backdrop-hue-rotate", function: "hue-rotate", values: hueRotateValues, backdrop: true, negative: true, order: backdropFilterFunctionsOrder + 40},
+	{name: "backdrop-invert", function: "invert", values: fullFilterValues, backdrop: true, order: backdropFilterFunctionsOrder + 50},
+	{name: "backdrop-opacity", function: "opacity", values: backdropOpacityValues, backdrop: true, order: backdropFilterFunctionsOrder + 60},
+	{name: "backdrop-saturate", function: "saturate", values: saturateValues, backdrop: true, order: backdropFilterFunctionsOrder + 70},
+	{name: "backdrop-sepia", function: "sepia", values: fullFilterValues, backdrop: true, order: backdropFilterFunctionsOrder + 80},
+}
+
+var filter = DeclarationsBaseClass{
+	name: "filter",
+	keywords: map[string][]CSSDeclaration{
+		"":     {{"filter", filterValue}},
+		"none": {{"filter", "none"}},
+	},
+	variables: filterVariables,
+	order:     filterOrder,
+}
+
+var backdropFilter = DeclarationsBaseClass{
+	name: "backdrop-filter",
+	keywords: map[string][]CSSDeclaration{
+		"":     {{"-webkit-backdrop-filter", backdropFilterValue}, {"backdrop-filter", backdropFilterValue}},
+		"none": {{"-webkit-backdrop-filter", "none"}, {"backdrop-filter", "none"}},
+	},
+	variables: backdropFilterVariables,
+	order:     backdropFilterOrder,
+}
+
+func transitionDeclarations(properties string) []CSSDeclaration {
+	return []CSSDeclaration{
+		{"transition-property", properties},
+		{"transition-timing-function", "cubic-bezier(0.4, 0, 0.2, 1)"},
+		{"transition-duration", "150ms"},
+	}
+}
+
+var transitionProperty = DeclarationsBaseClass{
+	name: "transition",
+	keywords: map[string][]CSSDeclaration{
+		"none":      {{"transition-property", "none"}},
+		"all":       transitionDeclarations("all"),
+		"":          transitionDeclarations("color, background-color, border-color, text-decoration-color, fill, stroke, opacity, box-shadow, transform, filter, backdrop-filter"),
+		"colors":    transitionDeclarations("color, background-color, border-color, text-decoration-color, fill, stroke"),
+		"opacity":   transitionDeclarations("opacity"),
+		"shadow":    transitionDeclarations("box-shadow"),
+		"transform": transitionDeclarations("transform"),
+	},
+	arbitrary: transitionDeclarations,
+	order:     transitionPropertyOrder,
+}
+
+var transitionTimes = map[string]string{
+	"0":    "0s",
+	"75":   "75ms",
+	"100":  "100ms",
+	"150":  "150ms",
+	"200":  "200ms",
+	"300":  "300ms",
+	"500":  "500ms",
+	"700":  "700ms",
+	"1000": "1000ms",
+}
+
+var transitionDuration = ArbitraryValueKeywordClass{
+	name:     "duration",
+	property: "transition-duration",
+	defaults: transitionTimes,
+	order:    transitionDurationOrder,
+}
+
+var transitionDelay = ArbitraryValueKeywordClass{
+	name:     "delay",
+	property: "transition-delay",
+	defaults: transitionTimes,
+	order:    transitionDelayOrder,
+}
+
+var transitionTimingFunction = ArbitraryValueKeywordClass{
+	name:     "ease",
+	property: "transition-timing-function",
+	defaults: map[string]string{
+		"linear": "linear",
+		"in":     "cubic-bezier(0.4, 0, 1, 1)",
+		"out":    "cubic-bezier(0, 0, 0.2, 1)",
+		"in-out": "cubic-bezier(0.4, 0, 0.2, 1)",
+	},
+	order: transitionTimingFunctionOrder,
+}
+
+type animationValue struct {
+	value     string
+	keyframes string
+}
+
+// the animation classes bring their @keyframes along with them
+type AnimationBaseClass struct {
+	name       string
+	animations map[string]animationValue
+	order      int
+}
+
+func (a AnimationBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range a.animations {
+		css := a.arbitraryValue(v.value)
+		if v.keyframes != "" {
+			css.AtRules = []string{v.keyframes}
+		}
+		m[a.name+"-"+k] = css
+	}
+	return m
+}
+
+// the keyframes for an arbitrary animation are up to the user
+func (a AnimationBaseClass) arbitraryValue(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: []CSSDeclaration{{"animation", v}}},
+		a.order,
+	}
+}
+func (a AnimationBaseClass) baseForArbitraryValue() string {
+	return a.name
+}
+
+var animation = AnimationBaseClass{
+	name: "animate",
+	animations: map[string]animationValue{
+		"none": {"none", ""},
+		"spin": {"spin 1s linear infinite", `@keyframes spin {
+  to {
+    transform: rotate(360deg);
+  }
+}
+`},
+		"ping": {"ping 1s cubic-bezier(0, 0, 0.2, 1) infinite", `@keyframes ping {
+  75%, 100% {
+    transform: scale(2);
+    opacity: 0;
+  }
+}
+`},
+		"pulse": {"pulse 2s cubic-bezier(0.4, 0, 0.6, 1) infinite", `@keyframes pulse {
+  50% {
+    opacity: .5;
+  }
+}
+`},
+		"bounce": {"bounce 1s infinite", `@keyframes bounce {
+  0%, 100% {
+    transform: translateY(-25%);
+    animation-timing-function: cubic-bezier(0.8, 0, 1, 1);
+  }
+  50% {
+    transform: none;
+    animation-timing-function: cubic-bezier(0, 0, 0.2, 1);
+  }
+}
+`},
+	},
+	order: animationOrder,
+}
+
+var cursor = ArbitraryValueKeywordClass{
+	name:     "cursor",
+	property: "cursor",
+	defaults: map[string]string{
+		"auto":          "auto",
+		"default":       "default",
+		"pointer":       "pointer",
+		"wait":          "wait",
+		"text":          "text",
+		"move":          "move",
+		"help":          "help",
+		"not-allowed":   "not-allowed",
+		"none":          "none",
+		"context-menu":  "context-menu",
+		"progress":      "progress",
+		"cell":          "cell",
+		"crosshair":     "crosshair",
+		"vertical-text": "vertical-text",
+		"alias":         "alias",
+		"copy":          "copy",
+		"no-drop":       "no-drop",
+		"grab":          "grab",
+		"grabbing":      "grabbing",
+		"all-scroll":    "all-scroll",
+		"col-resize":    "col-resize",
+		"row-resize":    "row-resize",
+		"n-resize":      "n-resize",
+		"e-resize":      "e-resize",
+		"s-resize":      "s-resize",
+		"w-resize":      "w-resize",
+		"ne-resize":     "ne-resize",
+		"nw-resize":     "nw-resize",
+		"se-resize":     "se-resize",
+		"sw-resize":     "sw-resize",
+		"ew-resize":     "ew-resize",
+		"ns-resize":     "ns-resize",
+		"nesw-resize":   "nesw-resize",
+		"nwse-resize":   "nwse-resize",
+		"zoom-in":       "zoom-in",
+		"zoom-out":      "zoom-out",
+	},
+	order: cursorOrder,
+}
+
+var pointerEvents = KeywordBaseClass{
+	name:     "pointer-events",
+	property: "pointer-events",
+	values: map[string]string{
+		"none": "none",
+		"auto": "auto",
+	},
+	order: pointerEventsOrder,
+}
+
+var userSelect = MultiPropertyKeywordClass{
+	name:       "select",
+	properties: []string{"-webkit-user-select", "user-select"},
+	defaults: map[string]string{
+		"none": "none",
+		"text": "text",
+		"all":  "all",
+		"auto": "auto",
+	},
+	order: userSelectOrder,
+}
+
+var resize = ArbitraryValueKeywordClass{
+	name:     "resize",
+	property: "resize",
+	defaults: map[string]string{
+		"none": "none",
+		"y":    "vertical",
+		"x":    "horizontal",
+		"":     "both",
+	},
+	order: resizeOrder,
+}
+
+var scrollBehavior = KeywordBaseClass{
+	name:     "scroll",
+	property: "scroll-behavior",
+	values: map[string]string{
+		"auto":   "auto",
+		"smooth": "smooth",
+	},
+	order: scrollBehaviorOrder,
+}
+
+var scrollMargins = genSidedSpacing("scroll-m", "scroll-margin", nil, true, scrollMarginOrder)
+
+var scrollPaddings = genSidedSpacing("scroll-p", "scroll-padding", nil, false, scrollPaddingOrder)
+
+// snap-x and snap-mandatory go together, the strictness is a variable so they can be written as two classes
+var scrollSnapType = DeclarationsBaseClass{
+	name: "snap",
+	keywords: map[string][]CSSDeclaration{
+		"none":      {{"scroll-snap-type", "none"}},
+		"x":         {{"scroll-snap-type", "x var(--tw-scroll-snap-strictness)"}},
+		"y":         {{"scroll-snap-type", "y var(--tw-scroll-snap-strictness)"}},
+		"both":      {{"scroll-snap-type", "both var(--tw-scroll-snap-strictness)"}},
+		"mandatory": {{"--tw-scroll-snap-strictness", "mandatory"}},
+		"proximity": {{"--tw-scroll-snap-strictness", "proximity"}},
+	},
+	variables: []CSSDeclaration{{"--tw-scroll-snap-strictness", "proximity"}},
+	order:     scrollSnapTypeOrder,
+}
+
+var scrollSnapAlign = KeywordBaseClass{
+	name:     "snap",
+	property: "scroll-snap-align",
+	values: map[string]string{
+		"start":      "start",
+		"end":        "end",
+		"center":     "center",
+		"align-none": "none",
+	},
+	order: scrollSnapAlignOrder,
+}
+
+var scrollSnapStop = KeywordBaseClass{
+	name:     "snap",
+	property: "scroll-snap-stop",
+	values: map[string]string{
+		"normal": "normal",
+		"always": "always",
+	},
+	order: scrollSnapStopOrder,
+}
+
+var touchAction = KeywordBaseClass{
+	name:     "touch",
+	property: "touch-action",
+	values: map[string]string{
+		"auto":         "auto",
+		"none":         "none",
+		"pan-x":        "pan-x",
+		"pan-left":     "pan-left",
+		"pan-right":    "pan-right",
+		"pan-y":        "pan-y",
+		"pan-up":       "pan-up",
+		"pan-down":     "pan-down",
+		"pinch-zoom":   "pinch-zoom",
+		"manipulation": "manipulation",
+	},
+	order: touchActionOrder,
+}
+
+var willChange = ArbitraryValueKeywordClass{
+	name:     "will-change",
+	property: "will-change",
+	defaults: map[string]string{
+		"auto":      "auto",
+		"scroll":    "scroll-position",
+		"contents":  "contents",
+		"transform": "transform",
+	},
+	order: willChangeOrder,
+}
+
+var appearance = KeywordBaseClass{
+	name:     "appearance",
+	property: "appearance",
+	values: map[string]string{
+		"none": "none",
+		"auto": "auto",
+	},
+	order: appearanceOrder,
+}
+
+var caretColor = ArbitraryColorBaseClass{
+	name:     "caret",
+	property: "caret-color",
+	order:    caretColorOrder,
+}
+
+var accentColor = ArbitraryColorBaseClass{
+	name:     "accent",
+	property: "accent-color",
+	order:    accentColorOrder,
+}
+
+var accentAuto = KeywordBaseClass{
+	name:     "accent",
+	property: "accent-color",
+	values:   map[string]string{"auto": "auto"},
+	order:    accentColorOrder,
+}
+
+// hides the element from everything but screen readers
+var srOnly = DeclarationsBaseClass{
+	name: "sr-only",
+	keywords: map[string][]CSSDeclaration{
+		"": {
+			{"position", "absolute"},
+			{"width", "1px"},
+			{"height", "1px"},
+			{"padding", "0"},
+			{"margin", "-1px"},
+			{"overflow", "hidden"},
+			{"clip", "rect(0, 0, 0, 0)"},
+			{"white-space", "nowrap"},
+			{"border-width", "0"},
+		},
+	},
+	order: srOnlyOrder,
+}
+
+// undoes sr-only, usually with a variant like focus:not-sr-only
+var notSrOnly = DeclarationsBaseClass{
+	name: "not-sr-only",
+	keywords: map[string][]CSSDeclaration{
+		"": {
+			{"position", "static"},
+			{"width", "auto"},
+			{"height", "auto"},
+			{"padding", "0"},
+			{"margin", "0"},
+			{"overflow", "visible"},
+			{"clip", "auto"},
+			{"white-space", "normal"},
+		},
+	},
+	order: srOnlyOrder,
+}
+
+func lineClampDeclarations(v string) []CSSDeclaration {
+	return []CSSDeclaration{
+		{"overflow", "hidden"},
+		{"display", "-webkit-box"},
+		{"-webkit-box-orient", "vertical"},
+		{"-webkit-line-clamp", v},
+	}
+}
+
+// cuts the text off after some lines, line-clamp-none puts everything back
+var lineClamp = DeclarationsBaseClass{
+	name: "line-clamp",
+	keywords: map[string][]CSSDeclaration{
+		"1": lineClampDeclarations("1"),
+		"2": lineClampDeclarations("2"),
+		"3": lineClampDeclarations("3"),
+		"4": lineClampDeclarations("4"),
+		"5": lineClampDeclarations("5"),
+		"6": lineClampDeclarations("6"),
+		"none": {
+			{"overflow", "visible"},
+			{"display", "block"},
+			{"-webkit-box-orient", "horizontal"},
+			{"-webkit-line-clamp", "none"},
+		},
+	},
+	arbitrary: lineClampDeclarations,
+	order:     lineClampOrder,
+}
+
+func contentDeclarations(v string) []CSSDeclaration {
+	return []CSSDeclaration{{"--tw-content", v}, {"content", "var(--tw-content)"}}
+}
+
+// for the pseudo elements, before:content-['*']
+var content = DeclarationsBaseClass{
+	name: "content",
+	keywords: map[string][]CSSDeclaration{
+		"none": contentDeclarations("none"),
+	},
+	arbitrary: contentDeclarations,
+	order:     contentOrder,
+}
+
+// grid values are usually more than one word, grid-cols-[200px_1fr] is the same as grid-template-columns: 200px 1fr
+type GridBaseClass struct {
+	name     string
+	property string
+	defaults map[string]string
+	// how an arbitrary value gets written, defaults to the value itself
+	format string
+	order  int
+}
+
+func (g GridBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range g.defaults {
+		m[g.name+"-"+k] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: g.property, Value: v}}},
+			g.order,
+		}
+	}
+	return m
+}
+
+func (g GridBaseClass) arbitraryValue(v string) OrderedCSS {
+	if g.format != "" {
+		v = fmt.Sprintf(g.format, v)
+	}
+	return OrderedCSS{
+		CSS{Declarations: []CSSDeclaration{{Property: g.property, Value: v}}},
+		g.order,
+	}
+}
+func (g GridBaseClass) baseForArbitraryValue() string {
+	return g.name
+}
+
+// makes "1" through "n" with format filled in with the number
+func gridNumbers(n int, format string, extra map[string]string) map[string]string {
+	m := map[string]string{}
+	for i := 1; i <= n; i++ {
+		m[strconv.Itoa(i)] = fmt.Sprintf(format, i)
+	}
+	maps.Copy(m, extra)
+	return m
+}
+
+var gridTemplateColumns = GridBaseClass{
+	name:     "grid-cols",
+	property: "grid-template-columns",
+	defaults: gridNumbers(12, "repeat(%d, minmax(0, 1fr))", map[string]string{"none": "none", "subgrid": "subgrid"}),
+	order:    gridTemplateColumnsOrder,
+}
+
+var gridTemplateRows = GridBaseClass{
+	name:     "grid-rows",
+	property: "grid-template-rows",
+	defaults: gridNumbers(12, "repeat(%d, minmax(0, 1fr))", map[string]string{"none": "none", "subgrid": "subgrid"}),
+	order:    gridTemplateRowsOrder,
+}
+
+var gridColumn = GridBaseClass{
+	name:     "col",
+	property: "grid-column",
+	defaults: map[string]string{"auto": "auto"},
+	order:    gridColumnOrder,
+}
+
+var gridColumnSpan = GridBaseClass{
+	name:     "col-span",
+	property: "grid-column",
+	defaults: gridNumbers(12, "span %[1]d / span %[1]d", map[string]string{"full": "1 / -1"}),
+	format:   "span %[1]s / span %[1]s",
+	order:    gridColumnOrder,
+}
+
+var gridColumnStart = GridBaseClass{
+	name:     "col-start",
+	property: "grid-column-start",
+	defaults: gridNumbers(13, "%d", map[string]string{"auto": "auto"}),
+	order:    gridColumnStartOrder,
+}
+
+var gridColumnEnd = GridBaseClass{
+	name:     "col-end",
+	property: "grid-column-end",
+	defaults: gridNumbers(13, "%d", map[string]string{"auto": "auto"}),
+	order:    gridColumnEndOrder,
+}
+
+var gridRow = GridBaseClass{
+	name:     "row",
+	property: "grid-row",
+	defaults: map[string]string{"auto": "auto"},
+	order:    gridRowOrder,
+}
+
+var gridRowSpan = GridBaseClass{
+	name:     "row-span",
+	property: "grid-row",
+	defaults: gridNumbers(12, "span %[1]d / span %[1]d", map[string]string{"full": "1 / -1"}),
+	format:   "span %[1]s / span %[1]s",
+	order:    gridRowOrder,
+}
+
+var gridRowStart = GridBaseClass{
+	name:     "row-start",
+	property: "grid-row-start",
+	defaults: gridNumbers(13, "%d", map[string]string{"auto": "auto"}),
+	order:    gridRowStartOrder,
+}
+
+var gridRowEnd = GridBaseClass{
+	name:     "row-end",
+	property: "grid-row-end",
+	defaults: gridNumbers(13, "%d", map[string]string{"auto": "auto"}),
+	order:    gridRowEndOrder,
+}
+
+var gridAutoFlow = KeywordBaseClass{
+	name:     "grid-flow",
+	property: "grid-auto-flow",
+	values: map[string]string{
+		"row":       "row",
+		"col":       "column",
+		"dense":     "dense",
+		"row-dense": "row dense",
+		"col-dense": "column dense",
+	},
+	order: gridAutoFlowOrder,
+}
+
+var gridAutoColumns = GridBaseClass{
+	name:     "auto-cols",
+	property: "grid-auto-columns",
+	defaults: map[string]string{
+		"auto": "auto",
+		"min":  "min-content",
+		"max":  "max-content",
+		"fr":   "minmax(0, 1fr)",
+	},
+	order: gridAutoColumnsOrder,
+}
+
+var gridAutoRows = GridBaseClass{
+	name:     "auto-rows",
+	property: "grid-auto-rows",
+	defaults: map[string]string{
+		"auto": "auto",
+		"min":  "min-content",
+		"max":  "max-content",
+		"fr":   "minmax(0, 1fr)",
+	},
+	order: gridAutoRowsOrder,
+}
+
+type ArbitraryColorBaseClass struct {
+	name     string
+	property string
+	// declarations that come after the color, shadow colors also have to switch --tw-shadow over
+	extra []CSSDeclaration
+	order int
+}
+
+func (a ArbitraryColorBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range defaultColors {
+		m[a.name+"-"+k] = a.arbitraryValue(v)
+	}
+	return m
+}
+func (a ArbitraryColorBaseClass) baseForArbitraryValue() string {
+	return a.name
+}
+func (a ArbitraryColorBaseClass) takesValue(t, v string) bool {
+	return t == "color"
+}
+func (a ArbitraryColorBaseClass) arbitraryValue(v string) OrderedCSS {
+	decls := append([]CSSDeclaration{{Property: a.property, Value: v}}, a.extra...)
+	return OrderedCSS{
+		CSS{Declarations: decls},
+		a.order,
+	}
+}
+
+// bg-red-500/50 and bg-[#123456]/[.4] set the opacity of the color
+func (a ArbitraryColorBaseClass) modifiedValue(value string, arbitrary bool, modifier string) (OrderedCSS, bool) {
+	color := value
+	if !arbitrary {
+		c, ok := defaultColors[value]
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		color = c
+	}
+	alpha, ok := parseOpacityModifier(modifier)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	color, ok = withOpacity(color, alpha)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	return a.arbitraryValue(color), true
+}
+
+// opacity-0 through opacity-100 in steps of 5
+func genOpacities() map[string]string {
+	m := map[string]string{}
+	for i := 0; i <= 100; i += 5 {
+		m[strconv.Itoa(i)] = strconv.FormatFloat(float64(i)/100, 'f', -1, 64)
+	}
+	return m
+}
+
+var opacity = ArbitraryValueKeywordClass{
+	name:     "opacity",
+	property: "opacity",
+	defaults: genOpacities(),
+	order:    opacityOrder,
+}
+
+var blendModes = map[string]string{
+	"normal":      "normal",
+	"multiply":    "multiply",
+	"screen":      "screen",
+	"overlay":     "overlay",
+	"darken":      "darken",
+	"lighten":     "lighten",
+	"color-dodge": "color-dodge",
+	"color-burn":  "color-burn",
+	"hard-light":  "hard-light",
+	"soft-light":  "soft-light",
+	"difference":  "difference",
+	"exclusion":   "exclusion",
+	"hue":         "hue",
+	"saturation":  "saturation",
+	"color":       "color",
+	"luminosity":  "luminosity",
+}
+
+var mixBlendMode = KeywordBaseClass{
+	name:     "mix-blend",
+	property: "mix-blend-mode",
+	// only mix-blend-mode has plus-lighter
+	values: concatMaps(blendModes, map[string]string{"plus-lighter": "plus-lighter"}),
+	order:  mixBlendModeOrder,
+}
+
+var backgroundBlendMode = KeywordBaseClass{
+	name:     "bg-blend",
+	property: "background-blend-mode",
+	values:   blendModes,
+	order:    backgroundBlendModeOrder,
+}
+
+var shadowColor = ArbitraryColorBaseClass{
+	name:     "shadow",
+	property: "--tw-shadow-color",
+	extra:    []CSSDeclaration{{"--tw-shadow", "var(--tw-shadow-colored)"}},
+	order:    boxShadowColorOrder,
+}
+
+var ringColor = ArbitraryColorBaseClass{
+	name:     "ring",
+	property: "--tw-ring-color",
+	order:    ringColorOrder,
+}
+
+var ringOffsetColor = ArbitraryColorBaseClass{
+	name:     "ring-offset",
+	property: "--tw-ring-offset-color",
+	order:    ringOffsetColorOrder,
+}
+
+var outlineColor = ArbitraryColorBaseClass{
+	name:     "outline",
+	property: "outline-color",
+	order:    outlineColorOrder,
+}
+
+var borderColor = ArbitraryColorBaseClass{
+	name:     "border",
+	property: "border-color",
+	order:    borderColorOrder,
+}
+
+var backgroundColor = ArbitraryColorBaseClass{
+	name:     "bg",
+	property: "background-color",
+	order:    backgroundColorOrder,
+}
+
+var backgroundImage = ArbitraryValueKeywordClass{
+	name:     "bg",
+	property: "background-image",
+	defaults: map[string]string{
+		"none":           "none",
+		"gradient-to-t":  "linear-gradient(to top, var(--tw-gradient-stops))",
+		"gradient-to-tr": "linear-gradient(to top right, var(--tw-gradient-stops))",
+		"gradient-to-r":  "linear-gradient(to right, var(--tw-gradient-stops))",
+		"gradient-to-br": "linear-gradient(to bottom right, var(--tw-gradient-stops))",
+		"gradient-to-b":  "linear-gradient(to bottom, var(--tw-gradient-stops))",
+		"gradient-to-bl": "linear-gradient(to bottom left, var(--tw-gradient-stops))",
+		"gradient-to-l":  "linear-gradient(to left, var(--tw-gradient-stops))",
+		"gradient-to-tl": "linear-gradient(to top left, var(--tw-gradient-stops))",
+	},
+	types: []string{"url", "image"},
+	order: backgroundImageOrder,
+}
+
+var backgroundSize = ArbitraryValueKeywordClass{
+	name:     "bg",
+	property: "background-size",
+	defaults: map[string]string{
+		"auto":    "auto",
+		"cover":   "cover",
+		"contain": "contain",
+	},
+	// like tailwind a bare length or percentage is a position, bg-[length:50%] is a size
+	hints: []string{"length", "size"},
+	order: backgroundSizeOrder,
+}
+
+var backgroundAttachment = KeywordBaseClass{
+	name:     "bg",
+	property: "background-attachment",
+	values: map[string]string{
+		"fixed":  "fixed",
+		"local":  "local",
+		"scroll": "scroll",
+	},
+	order: backgroundAttachmentOrder,
+}
+
+var backgroundClip = KeywordBaseClass{
+	name:     "bg-clip",
+	property: "background-clip",
+	values: map[string]string{
+		"border":  "border-box",
+		"padding": "padding-box",
+		"content": "content-box",
+		"text":    "text",
+	},
+	order: backgroundClipOrder,
+}
+
+var backgroundPosition = ArbitraryValueKeywordClass{
+	name:     "bg",
+	property: "background-position",
+	defaults: map[string]string{
+		"bottom":       "bottom",
+		"center":       "center",
+		"left":         "left",
+		"left-bottom":  "left bottom",
+		"left-top":     "left top",
+		"right":        "right",
+		"right-bottom": "right bottom",
+		"right-top":    "right top",
+		"top":          "top",
+	},
+	types: []string{"position", "length", "percentage"},
+	order: backgroundPositionOrder,
+}
+
+var backgroundRepeat = KeywordBaseClass{
+	name:     "bg",
+	property: "background-repeat",
+	values: map[string]string{
+		"repeat":       "repeat",
+		"no-repeat":    "no-repeat",
+		"repeat-x":     "repeat-x",
+		"repeat-y":     "repeat-y",
+		"repeat-round": "round",
+		"repeat-space": "space",
+	},
+	order: backgroundRepeatOrder,
+}
+
+var backgroundOrigin = KeywordBaseClass{
+	name:     "bg-origin",
+	property: "background-origin",
+	values: map[string]string{
+		"border":  "border-box",
+		"padding": "padding-box",
+		"content": "content-box",
+	},
+	order: backgroundOriginOrder,
+}
+
+var fill = ArbitraryColorBaseClass{
+	name:     "fill",
+	property: "fill",
+	order:    fillOrder,
+}
+
+var fillNone = KeywordBaseClass{
+	name:     "fill",
+	property: "fill",
+	values:   map[string]string{"none": "none"},
+	order:    fillOrder,
+}
+
+var stroke = ArbitraryColorBaseClass{
+	name:     "stroke",
+	property: "stroke",
+	order:    strokeOrder,
+}
+
+var strokeNone = KeywordBaseClass{
+	name:     "stroke",
+	property: "stroke",
+	values:   map[string]string{"none": "none"},
+	order:    strokeOrder,
+}
+
+// stroke-2 is a width and stroke-red-500 is a color, stroke-[2px] goes here and stroke-[#fff] goes to stroke
+var strokeWidth = ArbitraryValueKeywordClass{
+	name:     "stroke",
+	property: "stroke-width",
+	defaults: map[string]string{
+		"0": "0",
+		"1": "1",
+		"2": "2",
+	},
+	types: []string{"length", "number", "percentage"},
+	order: strokeWidthOrder,
+}
+
+var borderCollapse = KeywordBaseClass{
+	name:     "border",
+	property: "border-collapse",
+	values: map[string]string{
+		"collapse": "collapse",
+		"separate": "separate",
+	},
+	order: borderCollapseOrder,
+}
+
+// border-spacing-x-2 only changes one of the two so they are variables like the transforms
+var borderSpacingVariables = []CSSDeclaration{
+	{"--tw-border-spacing-x", "0"},
+	{"--tw-border-spacing-y", "0"},
+}
+
+// sets the variables of the axes and then the border-spacing that reads both of them
+func borderSpacingDeclarations(axes ...string) func(v string) []CSSDeclaration {
+	return func(v string) []CSSDeclaration {
+		decls := []CSSDeclaration{}
+		for _, a := range axes {
+			decls = append(decls, CSSDeclaration{"--tw-border-spacing-" + a, v})
+		}
+		return append(decls, CSSDeclaration{"border-spacing", "var(--tw-border-spacing-x) var(--tw-border-spacing-y)"})
+	}
+}
+
+var borderSpacings = []SpacingBaseClass{
+	{
+		name:         "border-spacing",
+		declarations: borderSpacingDeclarations("x", "y"),
+		variables:    borderSpacingVariables,
+		order:        borderSpacingOrder,
+	},
+	{
+		name:         "border-spacing-x",
+		declarations: borderSpacingDeclarations("x"),
+		variables:    borderSpacingVariables,
+		order:        borderSpacingOrder + 10,
+	},
+	{
+		name:         "border-spacing-y",
+		declarations: borderSpacingDeclarations("y"),
+		variables:    borderSpacingVariables,
+		order:        borderSpacingOrder + 20,
+	},
+}
+
+var tableLayout = KeywordBaseClass{
+	name:     "table",
+	property: "table-layout",
+	values: map[string]string{
+		"auto":  "auto",
+		"fixed": "fixed",
+	},
+	order: tableLayoutOrder,
+}
+
+var captionSide = KeywordBaseClass{
+	name:     "caption",
+	property: "caption-side",
+	values: map[string]string{
+		"top":    "top",
+		"bottom": "bottom",
+	},
+	order: captionSideOrder,
+}
+
+// the stops of every position start out empty, from-10% fills one in
+var gradientVariables = []CSSDeclaration{
+	{"--tw-gradient-from-position", emptyValue},
+	{"--tw-gradient-via-position", emptyValue},
+	{"--tw-gradient-to-position", emptyValue},
+}
+
+// from-*, via-* and to-* take either a color or the position of that stop
+type GradientStopBaseClass struct {
+	name string
+	// the variable that from-10% sets
+	position string
+	// the declarations for a color, transparent is the same color with no opacity so the gradient fades out
+	colors func(color, transparent string) []CSSDeclaration
+	order  int
+}
+
+// base is the color without an opacity modifier, via-blue-500/50 still fades out to rgb(59 130 246 / 0)
+func (g GradientStopBaseClass) colorCSS(color, base string) OrderedCSS {
+	transparent, ok := withOpacity(base, "0")
+	if !ok {
+		transparent = "transparent"
+	}
+	return OrderedCSS{
+		CSS{Declarations: g.colors(color, transparent), Variables: gradientVariables},
+		g.order,
+	}
+}
+
+func (g GradientStopBaseClass) positionCSS(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: []CSSDeclaration{{g.position, v}}},
+		g.order,
+	}
+}
+
+func (g GradientStopBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+	m := map[string]OrderedCSS{}
+	for k, v := range defaultColors {
+		m[g.name+"-"+k] = g.colorCSS(v, v)
+	}
+	for i := 0; i <= 100; i += 5 {
+		p := strconv.Itoa(i) + "%"
+		m[g.name+"-"+p] = g.positionCSS(p)
+	}
+	return m
+}
+
+func (g GradientStopBaseClass) arbitraryValue(v string) OrderedCSS {
+	if isLength(v) {
+		return g.positionCSS(v)
+	}
+	return g.colorCSS(v, v)
+}
+func (g GradientStopBaseClass) baseForArbitraryValue() string {
+	return g.name
+}
+
+func (g GradientStopBaseClass) modifiedValue(value string, arbitrary bool, modifier string) (OrderedCSS, bool) {
+	color := value
+	if !arbitrary {
+		c, ok := defaultColors[value]
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		color = c
+	} else if isLength(value) {
+		return OrderedCSS{}, false
+	}
+	alpha, ok := parseOpacityModifier(modifier)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	modified, ok := withOpacity(color, alpha)
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	return g.colorCSS(modified, color), true
+}
+
+var gradientColorStops = []GradientStopBaseClass{
+	{
+		name:     "from",
+		position: "--tw-gradient-from-position",
+		colors: func(color, transparent string) []CSSDeclaration {
+			return []CSSDeclaration{
+				{"--tw-gradient-from", color + " var(--tw-gradient-from-position)"},
+				{"--tw-gradient-to", transparent + " var(--tw-gradient-to-position)"},
+				{"--tw-gradient-stops", "var(--tw-gradient-from), var(--tw-gradient-to)"},
+			}
+		},
+		order: gradientColorStopsOrder,
+	},
+	{
+		name:     "via",
+		position: "--tw-gradient-via-position",
+		colors: func(color, transparent string) []CSSDeclaration {
+			return []CSSDeclaration{
+				{"--tw-gradient-to", transparent + " var(--tw-gradient-to-position)"},
+				{"--tw-gradient-stops", "var(--tw-gradient-from), " + color + " var(--tw-gradient-via-position), var(--tw-gradient-to)"},
+			}
+		},
+		order: gradientColorStopsOrder + 10,
+	},
+	{
+		name:     "to",
+		position: "--tw-gradient-to-position",
+		colors: func(color, transparent string) []CSSDeclaration {
+			return []CSSDeclaration{
+				{"--tw-gradient-to", color + " var(--tw-gradient-to-position)"},
+			}
+		},
+		order: gradientColorStopsOrder + 20,
+	},
+}
+var textColor = ArbitraryColorBaseClass{
+	name:     "text",
+	property: "color",
+	order:    textColorOrder,
+}
+
+var textAlign = KeywordBaseClass{
+	name:     "text",
+	property: "text-align",
+	values: map[string]string{
+		"left":    "left",
+		"center":  "center",
+		"right":   "right",
+		"justify": "justify",
+		"start":   "start",
+		"end":     "end",
+	},
+	order: textAlignOrder,
+}
+
+var listStyleType = ArbitraryValueKeywordClass{
+	name:     "list",
+	property: "list-style-type",
+	defaults: map[string]string{
+		"none":    "none",
+		"disc":    "disc",
+		"decimal": "decimal",
+	},
+	order: listStyleTypeOrder,
+}
+
+var listStylePosition = KeywordBaseClass{
+	name:     "list",
+	property: "list-style-position",
+	values: map[string]string{
+		"inside":  "inside",
+		"outside": "outside",
+	},
+	order: listStylePositionOrder,
+}
+
+var listStyleImage = ArbitraryValueKeywordClass{
+	name:     "list-image",
+	property: "list-style-image",
+	defaults: map[string]string{
+		"none": "none",
+	},
+	order: listStyleImageOrder,
+}
+
+var textIndent = SpacingBaseClass{
+	name:       "indent",
+	properties: []string{"text-indent"},
+	negative:   true,
+	order:      textIndentOrder,
+}
+
+var verticalAlign = ArbitraryValueKeywordClass{
+	name:     "align",
+	property: "vertical-align",
+	defaults: map[string]string{
+		"baseline":    "baseline",
+		"top":         "top",
+		"middle":      "middle",
+		"bottom":      "bottom",
+		"text-top":    "text-top",
+		"text-bottom": "text-bottom",
+		"sub":         "sub",
+		"super":       "super",
+	},
+	order: verticalAlignOrder,
+}
+
+var textTransform = RandomKeywordBaseClass{
+	keywords: map[string]string{
+		"uppercase":   "uppercase",
+		"lowercase":   "lowercase",
+		"capitalize":  "capitalize",
+		"normal-case": "none",
+	},
+	property: "text-transform",
+	order:    textTransformOrder,
+}
+
+var textDecorationLine = RandomKeywordBaseClass{
+	keywords: map[string]string{
+		"underline":    "underline",
+		"overline":     "overline",
+		"line-through": "line-through",
+		"no-underline": "none",
+	},
+	property: "text-decoration-line",
+	order:    textDecorationLineOrder,
+}
+
+var textDecorationColor = ArbitraryColorBaseClass{
+	name:     "decoration",
+	property: "text-decoration-color",
+	order:    textDecorationColorOrder,
+}
+
+var textDecorationStyle = KeywordBaseClass{
+	name:     "decoration",
+	property: "text-decoration-style",
+	values: map[string]string{
+		"solid":  "solid",
+		"double": "double",
+		"dotted": "dotted",
+		"dashed": "dashed",
+		"wavy":   "wavy",
+	},
+	order: textDecorationStyleOrder,
+}
+
+// decoration-2 is a thickness and decoration-red-500 is a color, decoration-[3px] goes here
+var textDecorationThickness = ArbitraryValueKeywordClass{
+	name:     "decoration",
+	property: "text-decoration-thickness",
+	defaults: map[string]string{
+		"auto":      "auto",
+		"from-font": "from-font",
+		"0":         "0px",
+		"1":         "1px",
+		"2":         "2px",
+		"4":         "4px",
+		"8":         "8px",
+	},
+	types: []string{"length", "percentage"},
+	order: textDecorationThicknessOrder,
+}
+
+var textUnderlineOffset = ArbitraryValueKeywordClass{
+	name:     "underline-offset",
+	property: "text-underline-offset",
+	defaults: map[string]string{
+		"auto": "auto",
+		"0":    "0px",
+		"1":    "1px",
+		"2":    "2px",
+		"4":    "4px",
+		"8":    "8px",
+	},
+	negative: true,
+	order:    textUnderlineOffsetOrder,
 }
 
-func (r RandomKeywordBaseClass) produceMap(config *Config) map[string]OrderedCSS {
-	m := map[string]OrderedCSS{}
-	for k, v := range r.keywords {
-		m[k] = OrderedCSS{
-			CSS{Declarations: []CSSDeclaration{{Property: r.property, Value: v}}},
-			r.order,
-		}
-	}
-	return m
+var fontWeight = ArbitraryValueKeywordClass{
+	name:     "font",
+	property: "font-weight",
+	defaults: map[string]string{
+		"thin":       "100",
+		"extralight": "200",
+		"light":      "300",
+		"normal":     "400",
+		"medium":     "500",
+		"semibold":   "600",
+		"bold":       "700",
+		"extrabold":  "800",
+		"black":      "900",
+	},
+	types: []string{"number"},
+	order: fontWeightOrder,
 }
 
-var display = RandomKeywordBaseClass{
-	keywords: map[string]string{
-		"block":              "block",
-		"inline-block":       "inline-block",
-		"inline":             "inline",
-		"flex":               "flex",
-		"inline-flex":        "inline-flex",
-		"table":              "table",
-		"inline-table":       "inline-table",
-		"table-caption":      "table-caption",
-		"table-cell":         "table-cell",
-		"table-column":       "table-column",
-		"table-column-group": "table-column-group",
-		"table-footer-group": "table-footer-group",
-		"table-header-group": "table-header-group",
-		"table-row-group":    "table-row-group",
-		"table-row":          "table-row",
-		"flow-root":          "flow-root",
-		"grid":               "grid",
-		"inline-grid":        "inline-grid",
-		"contents":           "contents",
-		"list-item":          "list-item",
-		// the exception behind the duplication
-		"hidden": "none",
+var lineHeight = ArbitraryValueKeywordClass{
+	name:     "leading",
+	property: "line-height",
+	defaults: map[string]string{
+		"3":       ".75rem",
+		"4":       "1rem",
+		"5":       "1.25rem",
+		"6":       "1.5rem",
+		"7":       "1.75rem",
+		"8":       "2rem",
+		"9":       "2.25rem",
+		"10":      "2.5rem",
+		"none":    "1",
+		"tight":   "1.25",
+		"snug":    "1.375",
+		"normal":  "1.5",
+		"relaxed": "1.625",
+		"loose":   "2",
 	},
-	property: "display",
-	order:    displayOrder,
+	order: lineHeightOrder,
 }
 
-var isolation = RandomKeywordBaseClass{
-	keywords: map[string]string{
-		"isolate":        "isolate",
-		"isolation-auto": "auto",
+var letterSpacing = ArbitraryValueKeywordClass{
+	name:     "tracking",
+	property: "letter-spacing",
+	defaults: map[string]string{
+		"tighter": "-0.05em",
+		"tight":   "-0.025em",
+		"normal":  "0em",
+		"wide":    "0.025em",
+		"wider":   "0.05em",
+		"widest":  "0.1em",
 	},
-	property: "isolation",
-	order:    isolationOrder,
+	negative: true,
+	order:    letterSpacingOrder,
 }
 
-type ArbitraryNumericalBaseClass struct {
-	name     string
-	property string
-	keywords map[string]string
-	order    int
+// makes a class for every font family, looks at defaultFontFamily when it is called so it follows the config
+type FontFamilyBaseClass struct {
+	name  string
+	order int
 }
 
-func (a ArbitraryNumericalBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+func (f FontFamilyBaseClass) produceMap(config *Config) map[string]OrderedCSS {
 	m := map[string]OrderedCSS{}
-	for _, num := range defaultNums {
-		n, err := strconv.ParseFloat(num, 64)
-		n = n / 4
-		// should not happen
-		if err != nil {
-			continue
-		}
-		m[a.name+"-"+num] = OrderedCSS{
-			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: fmt.Sprint(n) + "rem"}}},
-			a.order + numbersOrder,
-		}
-	}
-	for _, fraction := range defaultFractions {
-		n, err := parseFraction(fraction)
-		// should not happen
-		if err != nil {
-			continue
-		}
-		m[a.name+"-"+fraction] = OrderedCSS{
-			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: fmt.Sprint(n) + "%"}}},
-			a.order + fractionsOrder,
-		}
-	}
-	for k, v := range a.keywords {
-		m[a.name+"-"+k] = OrderedCSS{
-			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: v}}},
-			a.order + keywordsOrder,
+	for k, v := range defaultFontFamily {
+		m[f.name+"-"+k] = OrderedCSS{
+			CSS{Declarations: []CSSDeclaration{{Property: "font-family", Value: strings.Join(v, ", ")}}},
+			f.order,
 		}
 	}
 	return m
 }
 
-func (a ArbitraryNumericalBaseClass) arbitraryValue(v string) OrderedCSS {
+func (f FontFamilyBaseClass) arbitraryValue(v string) OrderedCSS {
 	return OrderedCSS{
-		CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: v}}},
-		a.order,
+		CSS{Declarations: []CSSDeclaration{{Property: "font-family", Value: v}}},
+		f.order,
 	}
 }
-func (a ArbitraryNumericalBaseClass) baseForArbitraryValue() string {
-	return a.name
+func (f FontFamilyBaseClass) baseForArbitraryValue() string {
+	return f.name
 }
 
-var flexBasis = ArbitraryNumericalBaseClass{
-	name:     "basis",
-	property: "flex-basis",
-	keywords: map[string]string{
-		"auto": "auto",
-		"full": "full",
-	},
-	order: 0,
+var fontFamily = FontFamilyBaseClass{
+	name:  "font",
+	order: fontFamilyOrder,
 }
 
-type ArbitraryColorBaseClass struct {
-	name     string
-	property string
-	order    int
+// every font size comes with a line height, text-sm/6 swaps it out for leading-6
+type FontSizeBaseClass struct {
+	name string
+	// font-size then line-height
+	sizes map[string][2]string
+	order int
 }
 
-func (a ArbitraryColorBaseClass) produceMap(config *Config) map[string]OrderedCSS {
+func (f FontSizeBaseClass) produceMap(config *Config) map[string]OrderedCSS {
 	m := map[string]OrderedCSS{}
-	for k, v := range defaultColors {
-		m[a.name+"-"+k] = OrderedCSS{
-			CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: v}}},
-			a.order,
-		}
+	for k, v := range f.sizes {
+		m[f.name+"-"+k] = f.css(v[0], v[1])
 	}
 	return m
 }
-func (a ArbitraryColorBaseClass) baseForArbitraryValue() string {
-	return a.name
+
+func (f FontSizeBaseClass) takesValue(t, v string) bool {
+	return t == "length" || t == "percentage"
 }
-func (a ArbitraryColorBaseClass) arbitraryValue(v string) OrderedCSS {
+
+func (f FontSizeBaseClass) css(size, lineHeight string) OrderedCSS {
 	return OrderedCSS{
-		CSS{Declarations: []CSSDeclaration{{Property: a.property, Value: v}}},
-		a.order,
+		CSS{Declarations: []CSSDeclaration{{"font-size", size}, {"line-height", lineHeight}}},
+		f.order,
 	}
 }
 
-var backgroundColor = ArbitraryColorBaseClass{
-	name:     "bg",
-	property: "background-color",
-	order:    0,
+func (f FontSizeBaseClass) arbitraryValue(v string) OrderedCSS {
+	return OrderedCSS{
+		CSS{Declarations: []CSSDeclaration{{"font-size", v}}},
+		f.order,
+	}
 }
-var textColor = ArbitraryColorBaseClass{
-	name:     "text",
-	property: "color",
-	order:    0,
+func (f FontSizeBaseClass) baseForArbitraryValue() string {
+	return f.name
+}
+
+func (f FontSizeBaseClass) modifiedValue(value string, arbitrary bool, modifier string) (OrderedCSS, bool) {
+	size := value
+	if !arbitrary {
+		v, ok := f.sizes[value]
+		if !ok {
+			return OrderedCSS{}, false
+		}
+		size = v[0]
+	}
+	lh, ok := lineHeight.defaults[modifier]
+	if strings.HasPrefix(modifier, "[") && strings.HasSuffix(modifier, "]") {
+		lh, ok = modifier[1:len(modifier)-1], true
+	}
+	if !ok {
+		return OrderedCSS{}, false
+	}
+	return f.css(size, lh), true
+}
+
+var fontSize = FontSizeBaseClass{
+	name: "text",
+	sizes: map[string][2]string{
+		"xs":   {"0.75rem", "1rem"},
+		"sm":   {"0.875rem", "1.25rem"},
+		"base": {"1rem", "1.5rem"},
+		"lg":   {"1.125rem", "1.75rem"},
+		"xl":   {"1.25rem", "1.75rem"},
+		"2xl":  {"1.5rem", "2rem"},
+		"3xl":  {"1.875rem", "2.25rem"},
+		"4xl":  {"2.25rem", "2.5rem"},
+		"5xl":  {"3rem", "1"},
+		"6xl":  {"3.75rem", "1"},
+		"7xl":  {"4.5rem", "1"},
+		"8xl":  {"6rem", "1"},
+		"9xl":  {"8rem", "1"},
+	},
+	order: fontSizeOrder,
 }
 
 //////////////////////////////////////////// VARIANTS
@@ -1180,6 +4967,8 @@ type Variant interface {
 func MakeVariants(c *Config) map[string]Variant {
 	return concatMaps(
 		variantMapFromArrs(pseudoClassVariants),
+		variantMapFromArrs(nthVariants),
+		variantMapFromArrs(attributeVariants),
 		variantMapFromArrs(pseudoElementVariants),
 		variantMapFromArrs([]DoublePseudoElementVariant{markerVariant}),
 		variantMapFromArrs(genBreakpointsVariant(c)),
@@ -1198,11 +4987,19 @@ func variantMapFromArrs[T Variant](arr []T) map[string]Variant {
 }
 
 type PseudoClassVariant struct {
-	name string
+	name  string
+	value string
+}
+
+func PseudoClassNameSameAsValue(n string) PseudoClassVariant {
+	return PseudoClassVariant{name: n, value: n}
 }
 
 func (p PseudoClassVariant) convert(arbitraryValue string, slashText string, c CSS) []CSS {
-	c.PseudoClasses = append(c.PseudoClasses, p.name)
+	if arbitraryValue != "" {
+		return nil
+	}
+	c.PseudoClasses = append(c.PseudoClasses, p.value)
 	return []CSS{c}
 }
 func (p PseudoClassVariant) base() string {
@@ -1210,13 +5007,80 @@ func (p PseudoClassVariant) base() string {
 }
 
 var pseudoClassVariants = []PseudoClassVariant{
-	{"hover"},
-	{"focus"},
-	{"focus-within"},
-	{"focus-visible"},
-	{"active"},
-	{"visited"},
-	{"target"},
+	PseudoClassNameSameAsValue("hover"),
+	PseudoClassNameSameAsValue("focus"),
+	PseudoClassNameSameAsValue("focus-within"),
+	PseudoClassNameSameAsValue("focus-visible"),
+	PseudoClassNameSameAsValue("active"),
+	PseudoClassNameSameAsValue("visited"),
+	PseudoClassNameSameAsValue("target"),
+	{"first", "first-child"},
+	{"last", "last-child"},
+	{"only", "only-child"},
+	{"odd", "nth-child(odd)"},
+	{"even", "nth-child(even)"},
+	PseudoClassNameSameAsValue("first-of-type"),
+	PseudoClassNameSameAsValue("last-of-type"),
+	PseudoClassNameSameAsValue("only-of-type"),
+	PseudoClassNameSameAsValue("empty"),
+	PseudoClassNameSameAsValue("disabled"),
+	PseudoClassNameSameAsValue("enabled"),
+	PseudoClassNameSameAsValue("checked"),
+	PseudoClassNameSameAsValue("indeterminate"),
+	PseudoClassNameSameAsValue("default"),
+	PseudoClassNameSameAsValue("required"),
+	PseudoClassNameSameAsValue("valid"),
+	PseudoClassNameSameAsValue("invalid"),
+	PseudoClassNameSameAsValue("in-range"),
+	PseudoClassNameSameAsValue("out-of-range"),
+	PseudoClassNameSameAsValue("placeholder-shown"),
+	PseudoClassNameSameAsValue("autofill"),
+	PseudoClassNameSameAsValue("read-only"),
+}
+
+// nth-[3n+1]:p-2 is :nth-child(3n+1), they only work with an arbitrary value
+type nthVariant struct {
+	name     string
+	function string
+}
+
+var nthVariants = []nthVariant{
+	{"nth", "nth-child"},
+	{"nth-last", "nth-last-child"},
+	{"nth-of-type", "nth-of-type"},
+	{"nth-last-of-type", "nth-last-of-type"},
+}
+
+func (n nthVariant) convert(arbitraryValue string, slashText string, c CSS) []CSS {
+	if arbitraryValue == "" {
+		return nil
+	}
+	c.PseudoClasses = append(c.PseudoClasses, n.function+"("+arbitraryValue+")")
+	return []CSS{c}
+}
+func (n nthVariant) base() string {
+	return n.name
+}
+
+// open:p-2 is for open details and dialog elements, they have the open attribute
+type attributeVariant struct {
+	name      string
+	attribute string
+}
+
+var attributeVariants = []attributeVariant{
+	{"open", "open"},
+}
+
+func (a attributeVariant) convert(arbitraryValue string, slashText string, c CSS) []CSS {
+	if arbitraryValue != "" {
+		return nil
+	}
+	c.AttributeSelectors = append(c.AttributeSelectors, a.attribute)
+	return []CSS{c}
+}
+func (a attributeVariant) base() string {
+	return a.name
 }
 
 type PseudoElementVariant struct {
@@ -1229,7 +5093,7 @@ func PseudoElementNameSameAsValue(n string) PseudoElementVariant {
 }
 
 func (p PseudoElementVariant) convert(arbitraryValue string, slashText string, c CSS) []CSS {
-	c.PseudoElements = append(c.PseudoClasses, p.name)
+	c.PseudoElements = append(c.PseudoElements, p.value)
 	return []CSS{c}
 }
 func (p PseudoElementVariant) base() string {
@@ -1357,7 +5221,7 @@ func (g groupVariant) convert(arbitraryValue, slashText string, c CSS) []CSS {
 	if slashText != "" {
 		s = "/" + slashText
 	}
-	c.GroupSelector = ".group" + s + g.after
+	c.GroupSelector = "." + escapeClassName("group"+s) + g.after
 	return []CSS{c}
 }
 
@@ -1367,6 +5231,31 @@ func (g groupVariant) base() string {
 
 //////////////////////////////////////////// UTILS
 
+// escapes a class name so it can go after the . in a selector, the same as CSS.escape in the browser
+// https://drafts.csswg.org/cssom/#serialize-an-identifier
+func escapeClassName(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		switch {
+		case r == 0:
+			sb.WriteRune('\uFFFD')
+		case (r >= 0x01 && r <= 0x1f) || r == 0x7f,
+			// a digit cannot start an identifier, 2xl:p-4 becomes \32 xl\:p-4
+			r >= '0' && r <= '9' && (i == 0 || (i == 1 && s[0] == '-')):
+			fmt.Fprintf(&sb, "\\%x ", r)
+		case i == 0 && r == '-' && len(s) == 1:
+			sb.WriteString("\\-")
+		case r >= 0x80 || r == '-' || r == '_' ||
+			(r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			sb.WriteRune(r)
+		default:
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
 func concatMaps[K comparable, V any](theMaps ...map[K]V) map[K]V {
 	out := map[K]V{}
 	for _, aMap := range theMaps {
@@ -1375,6 +5264,38 @@ func concatMaps[K comparable, V any](theMaps ...map[K]V) map[K]V {
 	return out
 }
 
+// the keys of defaultBreakpoints from the smallest screen to the biggest
+func sortedBreakpoints() []string {
+	ks := make([]string, 0, len(defaultBreakpoints))
+	for k := range defaultBreakpoints {
+		ks = append(ks, k)
+	}
+	slices.SortFunc(ks, func(a, b string) int {
+		aw, bw := screenWidth(defaultBreakpoints[a]), screenWidth(defaultBreakpoints[b])
+		if aw != bw {
+			if aw < bw {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	return ks
+}
+
+// the width of a screen like 640px or 40rem in pixels, only for comparing screens
+func screenWidth(v string) float64 {
+	n := strings.TrimRight(v, "abcdefghijklmnopqrstuvwxyz")
+	f, err := strconv.ParseFloat(n, 64)
+	if err != nil {
+		return 0
+	}
+	if strings.HasSuffix(v, "em") {
+		return f * 16
+	}
+	return f
+}
+
 func parseFraction(s string) (float64, error) {
 	parts := strings.Split(s, "/")
 	numerator, err := strconv.ParseFloat(parts[0], 64)
@@ -1388,6 +5309,226 @@ func parseFraction(s string) (float64, error) {
 	return numerator / denominator, nil
 }
 
+// sets all the properties to the same value
+func declarationsFor(properties []string, v string) []CSSDeclaration {
+	decls := make([]CSSDeclaration, len(properties))
+	for i, p := range properties {
+		decls[i] = CSSDeclaration{Property: p, Value: v}
+	}
+	return decls
+}
+
+// turns 1rem into -1rem and -1rem back into 1rem, anything fancier gets wrapped in a calc
+func negate(v string) string {
+	if strings.HasPrefix(v, "-") {
+		return v[1:]
+	}
+	if len(v) > 0 && (v[0] == '.' || (v[0] >= '0' && v[0] <= '9')) {
+		return "-" + v
+	}
+	return "calc(-1 * " + v + ")"
+}
+
+// 0.5 becomes 50%, 1/3 becomes 33.333333%
+func formatPercent(n float64) string {
+	s := strconv.FormatFloat(n*100, 'f', 6, 64)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	return s + "%"
+}
+
+var lengthUnits = []string{
+	"px", "rem", "em", "%", "ch", "ex", "lh", "rlh",
+	"vw", "vh", "vmin", "vmax", "svw", "svh", "lvw", "lvh", "dvw", "dvh",
+	"cqw", "cqh", "cm", "mm", "in", "pt", "pc",
+}
+
+// 2rem, 10px, 0 and calc(100% - 1rem) are lengths, red and #fff are not
+func isLength(v string) bool {
+	if v == "0" {
+		return true
+	}
+	for _, f := range []string{"calc(", "min(", "max(", "clamp("} {
+		if strings.HasPrefix(v, f) {
+			return true
+		}
+	}
+	for _, unit := range lengthUnits {
+		if !strings.HasSuffix(v, unit) {
+			continue
+		}
+		if _, err := strconv.ParseFloat(strings.TrimSuffix(v, unit), 64); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// 50 becomes 0.5, [.35] becomes .35 and [35%] stays 35%
+func parseOpacityModifier(modifier string) (string, bool) {
+	if strings.HasPrefix(modifier, "[") && strings.HasSuffix(modifier, "]") {
+		alpha := modifier[1 : len(modifier)-1]
+		return alpha, alpha != ""
+	}
+	n, err := strconv.ParseFloat(modifier, 64)
+	if err != nil || n < 0 || n > 100 {
+		return "", false
+	}
+	return strconv.FormatFloat(n/100, 'f', -1, 64), true
+}
+
+// turns #ef4444 or rgb(239 68 68) into rgb(239 68 68 / alpha)
+// colors that cannot be taken apart, like var(--x), get mixed with transparent instead
+func withOpacity(color, alpha string) (string, bool) {
+	if strings.HasPrefix(color, "#") {
+		hex := color[1:]
+		// drop the alpha channel, the modifier replaces it
+		if len(hex) == 4 || len(hex) == 8 {
+			hex = hex[:len(hex)*3/4]
+		}
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if len(hex) != 6 || err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("rgb(%d %d %d / %s)", n>>16, n>>8&0xff, n&0xff, alpha), true
+	}
+	if strings.HasPrefix(color, "rgb(") && strings.HasSuffix(color, ")") && !strings.Contains(color, "/") {
+		channels := strings.Split(color[len("rgb("):len(color)-1], ",")
+		// the old rgb(0,0,0) syntax cannot take a / alpha so it goes to the space separated one
+		// rgb(0,0,0,0.5) already has an alpha and gets mixed below
+		if len(channels) == 1 || len(channels) == 3 {
+			for i := range channels {
+				channels[i] = strings.TrimSpace(channels[i])
+			}
+			return "rgb(" + strings.Join(channels, " ") + " / " + alpha + ")", true
+		}
+	}
+	percent := alpha
+	if !strings.HasSuffix(alpha, "%") {
+		n, err := strconv.ParseFloat(alpha, 64)
+		if err != nil {
+			return "", false
+		}
+		percent = formatPercent(n)
+	}
+	return "color-mix(in srgb, " + color + " " + percent + ", transparent)", true
+}
+
+// #fff, rgb(0 0 0) and transparent are colors, 1px and inset are not
+func isColor(v string) bool {
+	switch v {
+	case "transparent", "currentColor", "currentcolor":
+		return true
+	}
+	if strings.HasPrefix(v, "#") {
+		return true
+	}
+	for _, f := range []string{"rgb(", "rgba(", "hsl(", "hsla(", "hwb(", "lab(", "lch(", "oklab(", "oklch(", "color(", "color-mix("} {
+		if strings.HasPrefix(v, f) {
+			return true
+		}
+	}
+	return false
+}
+
+var imageFunctions = []string{
+	"url(", "image(", "image-set(", "cross-fade(", "element(",
+	"linear-gradient(", "radial-gradient(", "conic-gradient(",
+	"repeating-linear-gradient(", "repeating-radial-gradient(", "repeating-conic-gradient(",
+}
+
+func isImage(v string) bool {
+	for _, f := range imageFunctions {
+		if strings.HasPrefix(v, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// the types an arbitrary value can be given with a hint, text-[length:var(--size)]
+// size is only ever a hint, inferType cannot tell it apart from a length or a position
+var arbitraryValueTypes = []string{"color", "length", "percentage", "number", "url", "image", "position", "size"}
+
+// splits length:2rem into length and 2rem, returns false if the value does not start with a type
+func cutTypeHint(v string) (string, string, bool) {
+	hint, rest, ok := strings.Cut(v, ":")
+	if !ok || !slices.Contains(arbitraryValueTypes, hint) {
+		return "", v, false
+	}
+	return hint, rest, true
+}
+
+// works out which of arbitraryValueTypes an arbitrary value is
+// returns "" if it cannot tell, like for var(--x)
+func inferType(v string) string {
+	switch {
+	case isColor(v):
+		return "color"
+	case strings.HasPrefix(v, "url("):
+		return "url"
+	case isImage(v):
+		return "image"
+	case isPercentage(v):
+		return "percentage"
+	case isLength(v):
+		return "length"
+	case isNumber(v):
+		return "number"
+	case isPosition(v):
+		return "position"
+	}
+	return ""
+}
+
+func isNumber(v string) bool {
+	_, err := strconv.ParseFloat(v, 64)
+	return err == nil
+}
+
+func isPercentage(v string) bool {
+	return strings.HasSuffix(v, "%") && isNumber(strings.TrimSuffix(v, "%"))
+}
+
+var positionKeywords = []string{"center", "top", "right", "bottom", "left"}
+
+// center, left top or right 10px bottom 20%, it needs at least one keyword since 10px 20px could just as well be a size
+func isPosition(v string) bool {
+	keyword := false
+	for _, part := range strings.Fields(v) {
+		if slices.Contains(positionKeywords, part) {
+			keyword = true
+		} else if !isLength(part) {
+			return false
+		}
+	}
+	return keyword
+}
+
+// splits on sep when it is not inside of parentheses
+func splitTopLevel(s string, sep byte) []string {
+	parts := []string{}
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
 //////////////////////////////////////////// DEFAULTS
 
 var defaultBreakpoints = map[string]string{
@@ -1398,6 +5539,9 @@ var defaultBreakpoints = map[string]string{
 	"2xl": "1536px",
 }
 
+// the container is not centered and has no padding unless the config says so
+var defaultContainer = ContainerOptions{}
+
 var defaultNums = []string{
 	"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12",
 	"14", "16",
@@ -1414,6 +5558,52 @@ var defaultFractions = []string{
 	"1/12", "2/12", "3/12", "4/12", "5/12", "6/12", "7/12", "8/12", "9/12", "10/12", "11/12",
 }
 
+// https://tailwindcss.com/docs/customizing-spacing
+var defaultSpacing = map[string]string{
+	"px":  "1px",
+	"0":   "0px",
+	"0.5": "0.125rem",
+	"1":   "0.25rem",
+	"1.5": "0.375rem",
+	"2":   "0.5rem",
+	"2.5": "0.625rem",
+	"3":   "0.75rem",
+	"3.5": "0.875rem",
+	"4":   "1rem",
+	"5":   "1.25rem",
+	"6":   "1.5rem",
+	"7":   "1.75rem",
+	"8":   "2rem",
+	"9":   "2.25rem",
+	"10":  "2.5rem",
+	"11":  "2.75rem",
+	"12":  "3rem",
+	"14":  "3.5rem",
+	"16":  "4rem",
+	"20":  "5rem",
+	"24":  "6rem",
+	"28":  "7rem",
+	"32":  "8rem",
+	"36":  "9rem",
+	"40":  "10rem",
+	"44":  "11rem",
+	"48":  "12rem",
+	"52":  "13rem",
+	"56":  "14rem",
+	"60":  "15rem",
+	"64":  "16rem",
+	"72":  "18rem",
+	"80":  "20rem",
+	"96":  "24rem",
+}
+
+// https://tailwindcss.com/docs/font-family
+var defaultFontFamily = map[string][]string{
+	"sans":  {"ui-sans-serif", "system-ui", "sans-serif", `"Apple Color Emoji"`, `"Segoe UI Emoji"`, `"Segoe UI Symbol"`, `"Noto Color Emoji"`},
+	"serif": {"ui-serif", "Georgia", "Cambria", `"Times New Roman"`, "Times", "serif"},
+	"mono":  {"ui-monospace", "SFMono-Regular", "Menlo", "Monaco", "Consolas", `"Liberation Mono"`, `"Courier New"`, "monospace"},
+}
+
 // https://tailwindcss.com/docs/customizing-colors
 var defaultColors = map[string]string{
 	"black": "rgb(0 0 0)",
@@ -3,8 +3,10 @@ package main
 import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"maps"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -57,9 +59,149 @@ func Helper(fileName string, t *testing.T, bs map[string]OrderedCSS) {
 func TestParseString(t *testing.T) {
 	bs := HandleConfigFile(nil)
 	Helper("tests/defaultstests.txt", t, bs)
-	//fileName := "tests/config.json"
-	//bs = HandleConfigFile(&fileName)
-	//Helper("tests/configtests.txt", t, bs)
+}
+
+func TestParseStringConfig(t *testing.T) {
+	// HandleConfigFile overwrites the defaults so put them back for the other tests
+	colors := maps.Clone(defaultColors)
+	breakpoints := maps.Clone(defaultBreakpoints)
+	spacing := maps.Clone(defaultSpacing)
+	fontFamily := maps.Clone(defaultFontFamily)
+	container := defaultContainer
+	t.Cleanup(func() {
+		defaultColors = colors
+		defaultBreakpoints = breakpoints
+		defaultSpacing = spacing
+		defaultFontFamily = fontFamily
+		defaultContainer = container
+	})
+	fileName := "tests/config.json"
+	bs := HandleConfigFile(&fileName)
+	// extend screens go with the screens, not the colors
+	assert.Equal(t, "200px", defaultBreakpoints["casio"])
+	assert.NotContains(t, defaultColors, "casio")
+	Helper("tests/configtests.txt", t, bs)
+}
+
+func TestVariablesBlock(t *testing.T) {
+	assert := assert.New(t)
+	bs := MakeBaseClasses(nil)
+	cs := append(ParseString("shadow-md", variants, bs), ParseString("ring-2", variants, bs)...)
+	cs = append(cs, ParseString("bg-red-500", variants, bs)...)
+	res := OrderedCSSArrToString(cs)
+	assert.Equal(1, strings.Count(res, "*, ::before, ::after {"))
+	assert.Equal(1, strings.Count(res, "--tw-shadow-colored: 0 0 #0000;"))
+	assert.Equal(1, strings.Count(res, "--tw-ring-inset: var(--tw-empty,/*!*/ /*!*/);"))
+	assert.True(strings.HasPrefix(res, "*, ::before, ::after {"))
+	assert.Equal("", OrderedCSSArrToString(nil))
+
+	cs = append(ParseString("rotate-45", variants, bs), ParseString("scale-50", variants, bs)...)
+	res = OrderedCSSArrToString(cs)
+	assert.Equal(1, strings.Count(res, "--tw-rotate: 0;"))
+	assert.Equal(2, strings.Count(res, "transform: translate(var(--tw-translate-x)"))
+
+	cs = append(ParseString("blur-sm", variants, bs), ParseString("grayscale", variants, bs)...)
+	res = OrderedCSSArrToString(cs)
+	assert.Equal(1, strings.Count(res, "--tw-grayscale: var(--tw-empty,/*!*/ /*!*/);"))
+	assert.Equal(2, strings.Count(res, "filter: var(--tw-blur)"))
+}
+
+func TestAtRules(t *testing.T) {
+	assert := assert.New(t)
+	bs := MakeBaseClasses(nil)
+	cs := append(ParseString("animate-spin", variants, bs), ParseString("hover:animate-spin", variants, bs)...)
+	cs = append(cs, ParseString("animate-ping", variants, bs)...)
+	res := OrderedCSSArrToString(cs)
+	assert.Equal(1, strings.Count(res, "@keyframes spin {"))
+	assert.Equal(1, strings.Count(res, "@keyframes ping {"))
+	assert.Less(strings.Index(res, "@keyframes spin {"), strings.Index(res, ".animate-spin {"))
+	assert.Equal(0, strings.Count(OrderedCSSArrToString(ParseString("animate-none", variants, bs)), "@keyframes"))
+}
+
+func TestContainer(t *testing.T) {
+	assert := assert.New(t)
+	bs := MakeBaseClasses(nil)
+	cs := ParseString("container", variants, bs)
+	assert.Len(cs, len(defaultBreakpoints)+1)
+	want := OrderedCSSArrToString(cs)
+	slices.Reverse(cs)
+	cs = append(cs, ParseString("p-4", variants, bs)...)
+	slices.SortFunc(cs, OrderedCSSLess)
+	assert.Equal(want, OrderedCSSArrToString(cs[:len(cs)-1]))
+	assert.Equal("p-4", cs[len(cs)-1].Selector)
+	assert.Equal([]string{"sm", "md", "lg", "xl", "2xl"}, sortedBreakpoints())
+
+	// -d and -l list the screens of the container too, before every other class
+	listed := sortedBaseClasses(bs)
+	assert.Equal(want, OrderedCSSArrToString(listed[:len(defaultBreakpoints)+1]))
+
+	// md:container only needs md and the screens above it
+	cs = ParseString("md:container", variants, bs)
+	assert.Len(cs, len(defaultBreakpoints))
+	assert.NotContains(OrderedCSSArrToString(cs), "max-width: 640px")
+}
+
+func TestNoDuplicateBaseClasses(t *testing.T) {
+	seen := map[string]bool{}
+	for _, m := range baseClassMaps(nil) {
+		for k := range m {
+			if seen[k] {
+				t.Errorf("%s is made by more than one base class", k)
+			}
+			seen[k] = true
+		}
+	}
+}
+
+func TestNegative(t *testing.T) {
+	assert := assert.New(t)
+	bs := MakeBaseClasses(nil)
+	assert.NotContains(bs, "-mt-4")
+	assert.NotNil(ParseString("-mt-4", variants, bs))
+	assert.Nil(ParseString("-mx-auto", variants, bs))
+	assert.Nil(ParseString("-order-first", variants, bs))
+	assert.Nil(ParseString("-p-4", variants, bs))
+	assert.Nil(ParseString("-[color:red]", variants, bs))
+	assert.Nil(ParseString("-top-auto", variants, bs))
+	for class, value := range map[string]string{
+		"-top-full":         "-100%",
+		"-inset-full":       "-100%",
+		"-translate-x-full": "-100%",
+		"-tracking-wide":    "-0.025em",
+		"-tracking-[.2em]":  "-.2em",
+		"-outline-offset-2": "-2px",
+		"-ring-offset-2":    "-2px",
+	} {
+		cs := ParseString(class, variants, bs)
+		if assert.NotNil(cs, class) {
+			assert.Equal(value, cs[0].Declarations[0].Value, class)
+		}
+	}
+}
+
+func TestPseudoClassVariants(t *testing.T) {
+	assert := assert.New(t)
+	bs := MakeBaseClasses(nil)
+	assert.Nil(ParseString("nth:p-2", variants, bs))
+	assert.Nil(ParseString("first-[2]:p-2", variants, bs))
+	assert.Nil(ParseString("open-[x]:p-2", variants, bs))
+
+	selector := func(class string) string {
+		cs := ParseString(class, variants, bs)
+		if !assert.NotEmpty(cs, class) {
+			return ""
+		}
+		return strings.SplitN(cs[0].String(), " {", 2)[0]
+	}
+	assert.Equal(`.open\:p-2[open]`, selector("open:p-2"))
+	assert.Equal(`.odd\:p-2:nth-child(odd)`, selector("odd:p-2"))
+	assert.Equal(`.nth-\[3n\+1\]\:p-2:nth-child(3n+1)`, selector("nth-[3n+1]:p-2"))
+	assert.Equal(`.first\:p-2:first-child`, selector("first:p-2"))
+	// the marker variant moves the rule onto the children but open is about the element
+	cs := ParseString("marker:open:p-2", variants, bs)
+	if assert.Len(cs, 2) {
+		assert.Contains(cs[1].String(), `.marker\:open\:p-2[open] *::marker {`)
+	}
 }
 
 func TestFormat(t *testing.T) {
@@ -148,6 +290,118 @@ func TestParse(t *testing.T) {
 	act = *parsestr([]byte(s))
 	assert.Equal(ex, act)
 
+	s = "a-[b_c]"
+	ex = fullClassInformation{class: parsedValue{name: "a", arbitraryText: "b c"}}
+	act = *parsestr([]byte(s))
+	assert.Equal(ex, act)
+
+	s = "a-[b\\_c]"
+	ex = fullClassInformation{class: parsedValue{name: "a", arbitraryText: "b_c"}}
+	act = *parsestr([]byte(s))
+	assert.Equal(ex, act)
+
+	s = "a-[url(/b_c.png)_d]"
+	ex = fullClassInformation{class: parsedValue{name: "a", arbitraryText: "url(/b_c.png) d"}}
+	act = *parsestr([]byte(s))
+	assert.Equal(ex, act)
+
+	s = "[&[open]]:a-[[b]]"
+	ex = fullClassInformation{
+		variants: []parsedValue{{name: "", arbitraryText: "&[open]"}},
+		class:    parsedValue{name: "a", arbitraryText: "[b]"},
+	}
+	act = *parsestr([]byte(s))
+	assert.Equal(ex, act)
+
+	assert.Nil(parsestr([]byte("a-[[b]")))
+
+	s = "a:!b-[c]/d"
+	ex = fullClassInformation{
+		variants: []parsedValue{{name: "a"}},
+		class:    parsedValue{name: "b", arbitraryText: "c", slashText: "d", important: true},
+	}
+	act = *parsestr([]byte(s))
+	assert.Equal(ex, act)
+
+	assert.Nil(parsestr([]byte("!a:b")))
+
+	s = "a:!-b-[c]"
+	ex = fullClassInformation{
+		variants: []parsedValue{{name: "a"}},
+		class:    parsedValue{name: "b", arbitraryText: "c", important: true, negative: true},
+	}
+	act = *parsestr([]byte(s))
+	assert.Equal(ex, act)
+
+	assert.Nil(parsestr([]byte("-a:b")))
+
+}
+
+func TestEscapeClassName(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("p-4", escapeClassName("p-4"))
+	assert.Equal("\\32 xl\\:p-4", escapeClassName("2xl:p-4"))
+	assert.Equal("-\\31 ", escapeClassName("-1"))
+	assert.Equal("\\-", escapeClassName("-"))
+	assert.Equal("--a", escapeClassName("--a"))
+	assert.Equal("\\1 a\\7f ", escapeClassName("\x01a\x7f"))
+	assert.Equal("\uFFFD", escapeClassName("\x00"))
+	assert.Equal("café", escapeClassName("café"))
+	assert.Equal("a\\ b", escapeClassName("a b"))
+	assert.Equal("\\!\\\\", escapeClassName("!\\"))
+
+	// the peer selector is escaped the same way groupVariant escapes the group one
+	c := CSS{Selector: "peer-hover/my.name:p-4", PeerSelector: "." + escapeClassName("peer/my.name") + ":hover", Declarations: []CSSDeclaration{{"padding", "1rem"}}}
+	assert.Equal(".peer\\/my\\.name:hover ~ .peer-hover\\/my\\.name\\:p-4 {\n  padding: 1rem;\n}\n", c.String())
+}
+
+func TestInferType(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("color", inferType("#fff"))
+	assert.Equal("color", inferType("rgb(0 0 0)"))
+	assert.Equal("url", inferType("url(/a.png)"))
+	assert.Equal("image", inferType("linear-gradient(red, blue)"))
+	assert.Equal("percentage", inferType("50%"))
+	assert.Equal("length", inferType("2rem"))
+	assert.Equal("length", inferType("calc(100% - 1px)"))
+	assert.Equal("number", inferType("600"))
+	assert.Equal("position", inferType("left top"))
+	assert.Equal("position", inferType("right 10px"))
+	assert.Equal("", inferType("10px 20px"))
+	assert.Equal("", inferType("var(--x)"))
+
+	hint, v, ok := cutTypeHint("length:var(--x)")
+	assert.True(ok)
+	assert.Equal("length", hint)
+	assert.Equal("var(--x)", v)
+	_, v, ok = cutTypeHint("color-mix(in srgb, red, blue)")
+	assert.False(ok)
+	assert.Equal("color-mix(in srgb, red, blue)", v)
+}
+
+func TestTypeHints(t *testing.T) {
+	assert := assert.New(t)
+	bs := MakeBaseClasses(nil)
+	assert.Nil(ParseString("text-[number:3]", variants, bs))
+	assert.Nil(ParseString("text-[number:3]/50", variants, bs))
+	assert.Nil(ParseString("bg-[number:3]", variants, bs))
+	assert.Equal("font-size", ParseString("text-[length:2rem]", variants, bs)[0].Declarations[0].Property)
+	assert.Equal("width", ParseString("w-[length:3px]", variants, bs)[0].Declarations[0].Property)
+}
+
+func TestWithOpacity(t *testing.T) {
+	assert := assert.New(t)
+	c, ok := withOpacity("#ff0000", "0.5")
+	assert.True(ok)
+	assert.Equal("rgb(255 0 0 / 0.5)", c)
+	c, _ = withOpacity("rgb(0 0 0)", "0.5")
+	assert.Equal("rgb(0 0 0 / 0.5)", c)
+	c, _ = withOpacity("rgb(0,0,0)", "0.5")
+	assert.Equal("rgb(0 0 0 / 0.5)", c)
+	c, _ = withOpacity("rgb(0, 0, 0)", "0.5")
+	assert.Equal("rgb(0 0 0 / 0.5)", c)
+	c, _ = withOpacity("rgb(0,0,0,0.5)", "0.5")
+	assert.Equal("color-mix(in srgb, rgb(0,0,0,0.5) 50%, transparent)", c)
 }
 
 func FuzzParseString(f *testing.F) {